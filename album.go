@@ -0,0 +1,237 @@
+package tgot
+
+import (
+	"cmp"
+	"slices"
+	"sync"
+	"time"
+
+	"github.com/karalef/tgot/api/tg"
+)
+
+// MediaGroupMaxSize is the maximum number of messages in the media group.
+const MediaGroupMaxSize = 10
+
+// default MediaGroups parameters.
+const (
+	DefaultMediaGroupDelay      = time.Second
+	DefaultMediaGroupLateWindow = time.Minute
+	DefaultMediaGroupMaxPending = 1000
+)
+
+// Album contains the messages of the media group.
+type Album struct {
+	ID string
+
+	// Messages are ordered by message id.
+	Messages []*tg.Message
+
+	// Caption contains the captions of all messages joined with a new line.
+	Caption         string
+	CaptionEntities []tg.MessageEntity
+}
+
+func newAlbum(id string, msgs []*tg.Message) *Album {
+	slices.SortFunc(msgs, func(a, b *tg.Message) int { return cmp.Compare(a.ID, b.ID) })
+	g := &Album{ID: id, Messages: msgs}
+	offset := 0
+	for _, msg := range msgs {
+		if msg.Caption == "" {
+			continue
+		}
+		if g.Caption != "" {
+			g.Caption += "\n"
+			offset++
+		}
+		g.Caption += msg.Caption
+		for _, e := range msg.CaptionEntities {
+			e.Offset += offset
+			g.CaptionEntities = append(g.CaptionEntities, e)
+		}
+		offset += tg.UTF16Len(msg.Caption)
+	}
+	return g
+}
+
+// MediaGroups buffers the messages by the media group id and calls the handler
+// once per media group after the quiet period.
+// It is safe for concurrent use, so it can be used as [Router.OnMessage].
+// The zero value is ready to use.
+type MediaGroups struct {
+	// Handler is called with the collected media group.
+	// The ctx is a context of the first received message of the group.
+	Handler func(*Message, *Album)
+
+	// Message is called for messages that are not a part of a media group.
+	Message func(*Message, *tg.Message)
+
+	// Late is called for messages of the already handled media groups.
+	// If it is nil, such messages are dropped.
+	Late func(*Message, *tg.Message)
+
+	// Delay is the quiet period after the last received message of the group.
+	// Default is DefaultMediaGroupDelay.
+	Delay time.Duration
+
+	// LateWindow specifies how long the handled media groups are remembered
+	// to detect the late messages. Default is DefaultMediaGroupLateWindow.
+	LateWindow time.Duration
+
+	// MaxPending limits the number of buffered media groups.
+	// When the limit is reached, the oldest group is handled immediately.
+	// Default is DefaultMediaGroupMaxPending.
+	MaxPending int
+
+	mut     sync.Mutex
+	pending map[mediaGroupKey]*pendingGroup
+	order   []*pendingGroup
+	handled map[mediaGroupKey]time.Time
+	expire  []handledGroup
+}
+
+type mediaGroupKey struct {
+	chat tg.ID
+	id   string
+}
+
+type pendingGroup struct {
+	key   mediaGroupKey
+	ctx   *Message
+	msgs  []*tg.Message
+	timer *time.Timer
+}
+
+type handledGroup struct {
+	key mediaGroupKey
+	at  time.Time
+}
+
+func (m *MediaGroups) delay() time.Duration {
+	if m.Delay > 0 {
+		return m.Delay
+	}
+	return DefaultMediaGroupDelay
+}
+
+func (m *MediaGroups) lateWindow() time.Duration {
+	if m.LateWindow > 0 {
+		return m.LateWindow
+	}
+	return DefaultMediaGroupLateWindow
+}
+
+func (m *MediaGroups) maxPending() int {
+	if m.MaxPending > 0 {
+		return m.MaxPending
+	}
+	return DefaultMediaGroupMaxPending
+}
+
+// Handle buffers the message if it is a part of a media group.
+func (m *MediaGroups) Handle(ctx *Message, msg *tg.Message) {
+	if msg.MediaGroupID == "" {
+		if m.Message != nil {
+			m.Message(ctx, msg)
+		}
+		return
+	}
+	key := mediaGroupKey{chat: msg.Chat.ID, id: msg.MediaGroupID}
+
+	m.mut.Lock()
+	if m.pending == nil {
+		m.pending = make(map[mediaGroupKey]*pendingGroup)
+		m.handled = make(map[mediaGroupKey]time.Time)
+	}
+	m.pruneHandled(time.Now())
+	if _, ok := m.handled[key]; ok {
+		m.mut.Unlock()
+		if m.Late != nil {
+			m.Late(ctx, msg)
+		}
+		return
+	}
+
+	g, ok := m.pending[key]
+	if !ok {
+		g = &pendingGroup{key: key, ctx: ctx}
+		g.timer = time.AfterFunc(m.delay(), func() { m.flush(g) })
+		m.pending[key] = g
+		m.order = append(m.order, g)
+	} else {
+		g.timer.Reset(m.delay())
+	}
+	g.msgs = append(g.msgs, msg)
+
+	var ready []*pendingGroup
+	if len(g.msgs) >= MediaGroupMaxSize {
+		ready = append(ready, m.take(g))
+	}
+	for len(m.pending) > m.maxPending() {
+		ready = append(ready, m.take(m.order[0]))
+	}
+	m.mut.Unlock()
+
+	for _, g := range ready {
+		m.handle(g)
+	}
+}
+
+// Flush handles all buffered media groups immediately.
+func (m *MediaGroups) Flush() {
+	m.mut.Lock()
+	ready := make([]*pendingGroup, 0, len(m.order))
+	for len(m.order) > 0 {
+		ready = append(ready, m.take(m.order[0]))
+	}
+	m.mut.Unlock()
+
+	for _, g := range ready {
+		m.handle(g)
+	}
+}
+
+func (m *MediaGroups) flush(g *pendingGroup) {
+	m.mut.Lock()
+	if m.pending[g.key] != g {
+		m.mut.Unlock()
+		return
+	}
+	m.take(g)
+	m.mut.Unlock()
+
+	m.handle(g)
+}
+
+// take removes the group from the pending ones and marks it as handled.
+func (m *MediaGroups) take(g *pendingGroup) *pendingGroup {
+	g.timer.Stop()
+	delete(m.pending, g.key)
+	if i := slices.Index(m.order, g); i != -1 {
+		m.order = slices.Delete(m.order, i, i+1)
+	}
+
+	now := time.Now()
+	m.handled[g.key] = now
+	m.expire = append(m.expire, handledGroup{g.key, now})
+	return g
+}
+
+func (m *MediaGroups) pruneHandled(now time.Time) {
+	window := m.lateWindow()
+	i := 0
+	for ; i < len(m.expire); i++ {
+		if now.Sub(m.expire[i].at) < window && len(m.expire)-i <= m.maxPending() {
+			break
+		}
+		if m.handled[m.expire[i].key].Equal(m.expire[i].at) {
+			delete(m.handled, m.expire[i].key)
+		}
+	}
+	m.expire = slices.Delete(m.expire, 0, i)
+}
+
+func (m *MediaGroups) handle(g *pendingGroup) {
+	if m.Handler != nil {
+		m.Handler(g.ctx, newAlbum(g.key.id, g.msgs))
+	}
+}
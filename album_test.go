@@ -0,0 +1,109 @@
+package tgot
+
+import (
+	"cmp"
+	"math/rand/v2"
+	"slices"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/karalef/tgot/api/tg"
+)
+
+func albumPart(chat tg.ID, group string, id int, caption string) *tg.Message {
+	return &tg.Message{
+		ID:           tg.ID(id),
+		Chat:         &tg.Chat{ID: chat},
+		MediaGroupID: group,
+		Caption:      caption,
+	}
+}
+
+func TestMediaGroupsOutOfOrder(t *testing.T) {
+	albums := make(chan *Album, 4)
+	m := &MediaGroups{
+		Handler: func(_ *Message, a *Album) { albums <- a },
+		Delay:   50 * time.Millisecond,
+	}
+
+	var parts []*tg.Message
+	for chat := range tg.ID(2) {
+		for _, group := range []string{"a", "b"} {
+			for id := range 5 {
+				parts = append(parts, albumPart(chat, group, id+1, group+string(rune('1'+id))))
+			}
+		}
+	}
+	rand.Shuffle(len(parts), func(i, j int) { parts[i], parts[j] = parts[j], parts[i] })
+
+	var wg sync.WaitGroup
+	for _, msg := range parts {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			m.Handle(nil, msg)
+		}()
+	}
+	wg.Wait()
+
+	for range 4 {
+		select {
+		case a := <-albums:
+			if len(a.Messages) != 5 {
+				t.Fatalf("album %s has %d messages", a.ID, len(a.Messages))
+			}
+			if !slices.IsSortedFunc(a.Messages, func(a, b *tg.Message) int { return cmp.Compare(a.ID, b.ID) }) {
+				t.Fatalf("album %s messages are not ordered", a.ID)
+			}
+			if expected := a.ID + "1\n" + a.ID + "2\n" + a.ID + "3\n" + a.ID + "4\n" + a.ID + "5"; a.Caption != expected {
+				t.Fatalf("unexpected caption %q", a.Caption)
+			}
+		case <-time.After(time.Second):
+			t.Fatal("album is not handled")
+		}
+	}
+	select {
+	case a := <-albums:
+		t.Fatalf("album %s is handled twice", a.ID)
+	case <-time.After(100 * time.Millisecond):
+	}
+}
+
+func TestMediaGroupsDelay(t *testing.T) {
+	albums := make(chan *Album, 1)
+	late := make(chan *tg.Message, 1)
+	m := &MediaGroups{
+		Handler: func(_ *Message, a *Album) { albums <- a },
+		Late:    func(_ *Message, msg *tg.Message) { late <- msg },
+		Delay:   200 * time.Millisecond,
+	}
+
+	// each part resets the quiet period
+	start := time.Now()
+	for id := range 3 {
+		m.Handle(nil, albumPart(1, "a", id+1, ""))
+		time.Sleep(20 * time.Millisecond)
+	}
+	select {
+	case a := <-albums:
+		if len(a.Messages) != 3 {
+			t.Fatalf("album has %d messages", len(a.Messages))
+		}
+		if elapsed := time.Since(start); elapsed < 240*time.Millisecond {
+			t.Fatalf("album is handled before the quiet period, after %s", elapsed)
+		}
+	case <-time.After(time.Second):
+		t.Fatal("album is not handled after the delay")
+	}
+
+	m.Handle(nil, albumPart(1, "a", 4, ""))
+	select {
+	case msg := <-late:
+		if msg.ID != 4 {
+			t.Fatalf("unexpected late message %d", msg.ID)
+		}
+	default:
+		t.Fatal("late message is not reported")
+	}
+}
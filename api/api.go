@@ -28,6 +28,12 @@ type Config struct {
 	APIURL  string // default: DefaultAPIURL
 	FileURL string // default: DefaultFileURL
 	Client  HTTP   // default: http.DefaultClient
+
+	// Encoding is used for the requests without files.
+	Encoding Encoding // default: EncodingForm
+
+	// Limits are checked before uploading the files.
+	Limits *Limits // default: CloudLimits
 }
 
 // New creates a new API instance.
@@ -44,11 +50,16 @@ func New(token string, cfg Config) (*API, error) {
 	if cfg.Client == nil {
 		cfg.Client = NewHTTP(http.DefaultClient)
 	}
+	if cfg.Limits == nil {
+		cfg.Limits = &CloudLimits
+	}
 	return &API{
 		token:   token,
 		apiURL:  tokenURL(cfg.APIURL, token),
 		fileURL: tokenURL(cfg.FileURL, token),
 		client:  cfg.Client,
+		enc:     cfg.Encoding,
+		limits:  *cfg.Limits,
 	}, nil
 }
 
@@ -58,6 +69,8 @@ type API struct {
 	apiURL  string
 	fileURL string
 	client  HTTP
+	enc     Encoding
+	limits  Limits
 }
 
 func (a API) methodURL(method string) string { return a.apiURL + method }
@@ -86,7 +99,10 @@ func (a *API) Request(ctx context.Context, method string, d *Data) error {
 
 // Request performs a request to the Bot API.
 func Request[T any](ctx context.Context, a *API, method string, data *Data) (result T, err error) {
-	ctype, reader := data.Data()
+	if err = data.CheckLimits(a.limits); err != nil {
+		return result, err
+	}
+	ctype, reader := data.Encode(ctx, a.enc)
 	url := a.methodURL(method)
 	code, body, err := a.client.Post(ctx, url, ctype, reader)
 	if err != nil {
@@ -118,8 +134,51 @@ func (a *API) DownloadFile(ctx context.Context, path string) (io.ReadCloser, err
 	body, err := a.get(ctx, a.pathURL(path))
 	if err != nil {
 		err.URL = strings.Replace(err.URL, a.token, "...", 1)
+		return nil, err
+	}
+	return body, nil
+}
+
+// DownloadFileFrom downloads a file from the server starting from the offset.
+// If the HTTP client does not implement RangeHTTP or the server ignores
+// the range, the first offset bytes are skipped.
+func (a *API) DownloadFileFrom(ctx context.Context, path string, offset int64) (io.ReadCloser, error) {
+	rh, ok := a.client.(RangeHTTP)
+	if offset <= 0 || !ok {
+		body, err := a.DownloadFile(ctx, path)
+		if err != nil {
+			return nil, err
+		}
+		return skip(body, offset)
+	}
+
+	url := a.pathURL(path)
+	code, body, err := rh.GetRange(ctx, url, offset)
+	if err != nil || code != http.StatusOK && code != http.StatusPartialContent {
+		if body != nil {
+			body.Close()
+		}
+		return nil, &HTTPError{
+			Status: code,
+			Err:    err,
+			URL:    strings.Replace(url, a.token, "...", 1),
+		}
+	}
+	if code == http.StatusOK {
+		return skip(body, offset)
 	}
-	return body, err
+	return body, nil
+}
+
+func skip(body io.ReadCloser, n int64) (io.ReadCloser, error) {
+	if n <= 0 {
+		return body, nil
+	}
+	if _, err := io.CopyN(io.Discard, body, n); err != nil {
+		body.Close()
+		return nil, err
+	}
+	return body, nil
 }
 
 func makeError[T error](method string, d *Data, err T) (e baseError[T]) {
@@ -2,6 +2,8 @@ package cmd
 
 import (
 	"strings"
+	"unicode"
+	"unicode/utf8"
 
 	"github.com/karalef/tgot/api/tg"
 )
@@ -11,6 +13,9 @@ type Command struct {
 	Name    string
 	Mention string
 	Args    []string
+
+	// Tokens contains the arguments with their positions and entities.
+	Tokens []Token
 }
 
 // Prefix is the character with which commands must begin.
@@ -21,8 +26,8 @@ func Parse(c string) (cmd Command) {
 	if len(c) < 2 || c[0] != Prefix {
 		return cmd
 	}
-	split := strings.Split(c[1:], " ")
-	cmd.Name, cmd.Args = split[0], split[1:]
+	cmd.Name, _, _ = strings.Cut(c[1:], " ")
+	cmd.setTokens(Tokenize(c, len(cmd.Name)+2, nil))
 	if i := strings.Index(cmd.Name, "@"); i != -1 && len(cmd.Name) > i+1 {
 		cmd.Mention = cmd.Name[i+1:]
 		cmd.Name = cmd.Name[:i]
@@ -53,7 +58,7 @@ func ParseEntities(text string, ents []tg.MessageEntity) (cmd Command) {
 	cmd.Name = text[:ents[0].Length]
 
 	if len(text) > len(cmd.Name)+1 {
-		cmd.Args = strings.Split(text[len(cmd.Name)+1:], " ")
+		cmd.setTokens(Tokenize(text, len(cmd.Name)+1, ents))
 	}
 
 	if i := strings.Index(cmd.Name, "@"); i != -1 && len(cmd.Name) > i+1 {
@@ -63,3 +68,171 @@ func ParseEntities(text string, ents []tg.MessageEntity) (cmd Command) {
 	cmd.Name = cmd.Name[1:]
 	return
 }
+
+func (c *Command) setTokens(tokens []Token) {
+	c.Tokens = tokens
+	if len(tokens) == 0 {
+		return
+	}
+	c.Args = make([]string, len(tokens))
+	for i := range tokens {
+		c.Args[i] = tokens[i].Value
+	}
+}
+
+// Token represents an argument with its position in the text.
+type Token struct {
+	Value string
+
+	// Offset and Length of the token in the text in UTF-16 code units.
+	// For the quoted arguments they include the quotes.
+	Offset int
+	Length int
+
+	// Entity contains the entity that covers the token entirely.
+	// Nil if the token is not an entity.
+	Entity *tg.MessageEntity
+}
+
+// User returns the mentioned user for text mentions.
+func (t Token) User() *tg.User {
+	if t.Entity == nil || t.Entity.Type != tg.EntityTextMention {
+		return nil
+	}
+	return t.Entity.User
+}
+
+// URL returns the URL for text links and URLs.
+func (t Token) URL() string {
+	switch {
+	case t.Entity == nil:
+		return ""
+	case t.Entity.Type == tg.EntityTextLink:
+		return t.Entity.URL
+	case t.Entity.Type == tg.EntityURL:
+		return t.Value
+	}
+	return ""
+}
+
+// Split splits the arguments string by whitespaces.
+// The arguments can be enclosed in double, single or typographic quotes to
+// include whitespaces (the quote is recognized only at the beginning of the
+// argument). Inside the quotes the backslash escapes the next character.
+func Split(s string) []string {
+	tokens := Tokenize(s, 0, nil)
+	args := make([]string, len(tokens))
+	for i := range tokens {
+		args[i] = tokens[i].Value
+	}
+	return args
+}
+
+// Tokenize splits text[start:] into tokens like Split but keeps the
+// entities which can not be split (mentions, links, code, etc.) as a single
+// token. The entities must be sorted by offset and their offsets must be
+// relative to the text.
+func Tokenize(text string, start int, ents []tg.MessageEntity) []Token {
+	if start > len(text) {
+		return nil
+	}
+	var (
+		tokens []Token
+		sb     strings.Builder
+		tok    *Token
+		quote  rune
+		esc    bool
+	)
+	off := tg.UTF16Len(text[:start])
+	end := func() {
+		tok.Length = off - tok.Offset
+		tok.Value = sb.String()
+		tokens = append(tokens, *tok)
+		sb.Reset()
+		tok = nil
+	}
+
+	for i := start; i < len(text); {
+		for len(ents) > 0 && ents[0].Offset < off {
+			ents = ents[1:]
+		}
+		if tok == nil {
+			if e := solidAt(ents, off); e != nil {
+				j, o := i, off
+				for j < len(text) && o < e.Offset+e.Length {
+					r, size := utf8.DecodeRuneInString(text[j:])
+					j += size
+					o += tg.UTF16RuneLen(r)
+				}
+				e := *e
+				tokens = append(tokens, Token{
+					Value:  text[i:j],
+					Offset: off,
+					Length: o - off,
+					Entity: &e,
+				})
+				i, off = j, o
+				continue
+			}
+		}
+
+		r, size := utf8.DecodeRuneInString(text[i:])
+		switch {
+		case esc:
+			sb.WriteRune(r)
+			esc = false
+		case quote != 0 && r == '\\':
+			esc = true
+		case quote != 0 && r == quote:
+			quote = 0
+		case quote != 0:
+			sb.WriteRune(r)
+		case unicode.IsSpace(r):
+			if tok != nil {
+				end()
+			}
+		case tok == nil && closingQuote(r) != 0:
+			quote = closingQuote(r)
+			tok = &Token{Offset: off}
+		default:
+			if tok == nil {
+				tok = &Token{Offset: off}
+			}
+			sb.WriteRune(r)
+		}
+		i += size
+		off += tg.UTF16RuneLen(r)
+	}
+	if tok != nil {
+		end()
+	}
+	return tokens
+}
+
+// solidAt returns the entity that starts at the offset and can not be split.
+func solidAt(ents []tg.MessageEntity, off int) *tg.MessageEntity {
+	for i := 0; i < len(ents) && ents[i].Offset == off; i++ {
+		switch ents[i].Type {
+		case tg.EntityMention, tg.EntityHashtag, tg.EntityCashtag,
+			tg.EntityCommand, tg.EntityURL, tg.EntityEmail, tg.EntityPhone,
+			tg.EntityCode, tg.EntityCodeBlock, tg.EntityTextLink,
+			tg.EntityTextMention, tg.EntityCustomEmoji:
+			if ents[i].Length > 0 {
+				return &ents[i]
+			}
+		}
+	}
+	return nil
+}
+
+func closingQuote(r rune) rune {
+	switch r {
+	case '"', '\'':
+		return r
+	case '“':
+		return '”'
+	case '«':
+		return '»'
+	}
+	return 0
+}
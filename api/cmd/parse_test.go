@@ -0,0 +1,44 @@
+package cmd
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/karalef/tgot/api/tg"
+)
+
+func TestSplit(t *testing.T) {
+	args := Split(`  a "b c" don't 'd \' e'  “f g” `)
+	expected := []string{"a", "b c", "don't", "d ' e", "f g"}
+	if !reflect.DeepEqual(args, expected) {
+		t.Fatalf("expected %q, got %q", expected, args)
+	}
+}
+
+func TestParseEntities(t *testing.T) {
+	user := &tg.User{ID: 42, FirstName: "Jöhn"}
+	// "😀" takes 2 UTF-16 code units.
+	text := "/ban@bot 😀 Jöhn Smith @someone a b"
+	ents := []tg.MessageEntity{
+		{Type: tg.EntityCommand, Offset: 0, Length: 8},
+		{Type: tg.EntityTextMention, Offset: 12, Length: 10, User: user},
+		{Type: tg.EntityMention, Offset: 23, Length: 8},
+		{Type: tg.EntityCode, Offset: 32, Length: 3},
+	}
+	c := ParseEntities(text, ents)
+	if c.Name != "ban" || c.Mention != "bot" {
+		t.Fatalf("unexpected command %s@%s", c.Name, c.Mention)
+	}
+	expected := []Token{
+		{Value: "😀", Offset: 9, Length: 2},
+		{Value: "Jöhn Smith", Offset: 12, Length: 10, Entity: &ents[1]},
+		{Value: "@someone", Offset: 23, Length: 8, Entity: &ents[2]},
+		{Value: "a b", Offset: 32, Length: 3, Entity: &ents[3]},
+	}
+	if !reflect.DeepEqual(c.Tokens, expected) {
+		t.Fatalf("expected %+v, got %+v", expected, c.Tokens)
+	}
+	if c.Tokens[1].User() != user {
+		t.Fatal("text mention is not resolved")
+	}
+}
@@ -1,8 +1,11 @@
 package api
 
 import (
+	"bytes"
+	"context"
 	"encoding/json"
 	"io"
+	"math"
 	"mime/multipart"
 	"net/url"
 	"reflect"
@@ -40,6 +43,13 @@ type Data struct {
 	// contains files that will be uploaded, where key is a multipart field.
 	Upload map[string]*tg.InputFile
 
+	// contains the params which values are valid JSON values
+	// and can be embedded into the JSON body as is.
+	raw map[string]struct{}
+
+	// contains the uploaded files that are sent as photos.
+	photos map[*tg.InputFile]struct{}
+
 	counter int
 }
 
@@ -55,16 +65,18 @@ func (d *Data) Reset() *Data {
 	for k := range d.Upload {
 		delete(d.Upload, k)
 	}
+	for k := range d.raw {
+		delete(d.raw, k)
+	}
+	for k := range d.photos {
+		delete(d.photos, k)
+	}
 	return d
 }
 
 // Copy copies Data's params.
 func (d *Data) Copy() *Data {
-	cp := NewData()
-	for k, v := range d.Params {
-		cp.Params[k] = v
-	}
-	return cp
+	return d.WriteTo(NewData())
 }
 
 // WriteTo copies Data's params to dst and returns dst.
@@ -75,16 +87,29 @@ func (d *Data) WriteTo(dst *Data) *Data {
 	for k, v := range d.Params {
 		dst.Params[k] = v
 	}
+	for k := range d.raw {
+		dst.setRaw(k, d.Params[k])
+	}
 	return dst
 }
 
 // Data encodes the values into “URL encoded” form or multipart/form-data.
 func (d *Data) Data() (string, io.Reader) {
+	return d.Encode(context.Background(), EncodingForm)
+}
+
+// Encode encodes the values using the encoding.
+// The data with files is always encoded as multipart/form-data
+// and streamed until the ctx is done.
+func (d *Data) Encode(ctx context.Context, enc Encoding) (string, io.Reader) {
 	if d == nil || len(d.Params) == 0 && len(d.Upload) == 0 {
 		return "", nil
 	}
 	if len(d.Upload) > 0 {
-		return d.writeMultipart()
+		return d.writeMultipart(ctx)
+	}
+	if enc == EncodingJSON {
+		return "application/json", bytes.NewReader(d.appendJSON(nil))
 	}
 
 	vals := make(url.Values, len(d.Params))
@@ -98,7 +123,18 @@ func (d *Data) Data() (string, io.Reader) {
 func (d *Data) Set(k, v string, force ...bool) *Data {
 	if v != "" || len(force) > 0 && force[0] {
 		d.Params[k] = v
+		delete(d.raw, k)
+	}
+	return d
+}
+
+// setRaw sets the key to the value which is a valid JSON value.
+func (d *Data) setRaw(k, v string) *Data {
+	if d.raw == nil {
+		d.raw = make(map[string]struct{})
 	}
+	d.Params[k] = v
+	d.raw[k] = struct{}{}
 	return d
 }
 
@@ -110,7 +146,7 @@ func (d *Data) SetInt(key string, v int, force ...bool) *Data {
 // SetInt64 sets the key to int64 value.
 func (d *Data) SetInt64(key string, v int64, force ...bool) *Data {
 	if v != 0 || len(force) > 0 && force[0] {
-		d.Set(key, strconv.FormatInt(v, 10))
+		d.setRaw(key, strconv.FormatInt(v, 10))
 	}
 	return d
 }
@@ -123,7 +159,7 @@ func (d *Data) SetUint(key string, v uint, force ...bool) *Data {
 // SetUint64 sets the key to uint64 value.
 func (d *Data) SetUint64(key string, v uint64, force ...bool) *Data {
 	if v != 0 || len(force) > 0 && force[0] {
-		d.Set(key, strconv.FormatUint(v, 10))
+		d.setRaw(key, strconv.FormatUint(v, 10))
 	}
 	return d
 }
@@ -136,7 +172,12 @@ func (d *Data) SetFloat(key string, v float32, force ...bool) *Data {
 // SetFloat64 sets the key to float64 value.
 func (d *Data) SetFloat64(key string, v float64, force ...bool) *Data {
 	if v != 0 || len(force) > 0 && force[0] {
-		d.Set(key, strconv.FormatFloat(v, 'f', 6, 64))
+		s := strconv.FormatFloat(v, 'f', 6, 64)
+		if math.IsInf(v, 0) || math.IsNaN(v) {
+			d.Set(key, s)
+		} else {
+			d.setRaw(key, s)
+		}
 	}
 	return d
 }
@@ -144,7 +185,7 @@ func (d *Data) SetFloat64(key string, v float64, force ...bool) *Data {
 // SetBool sets the key to bool value.
 func (d *Data) SetBool(key string, v bool, force ...bool) *Data {
 	if v || len(force) > 0 && force[0] {
-		d.Set(key, strconv.FormatBool(v))
+		d.setRaw(key, strconv.FormatBool(v))
 	}
 	return d
 }
@@ -169,8 +210,9 @@ func (d *Data) SetChatID(key string, id tg.ChatID) *Data {
 // SetJSON sets the key to JSON value.
 func (d *Data) SetJSON(key string, v any) *Data {
 	if v != nil {
-		b, _ := json.Marshal(v)
-		d.Set(key, string(b))
+		if b, err := json.Marshal(v); err == nil {
+			d.setRaw(key, string(b))
+		}
 	}
 	return d
 }
@@ -182,11 +224,15 @@ func (d *Data) SetFile(key string, file tg.Inputtable) *Data {
 	}
 
 	if inp, ok := file.(*tg.InputFile); ok {
+		if key == "photo" {
+			d.markPhoto(inp)
+		}
 		return d.addFile(key, inp)
 	}
 
 	urlid, _ := file.FileData()
 	d.Params[key] = urlid
+	delete(d.raw, key)
 	return d
 }
 
@@ -200,6 +246,7 @@ func (d *Data) SetInput(key string, v tg.Inputter) *Data {
 	for _, inp := range v.GetInput() {
 		d.AddAttach(inp)
 	}
+	d.markPhotos(v)
 	return d.SetJSON(key, v)
 }
 
@@ -216,10 +263,15 @@ func (d *Data) AddAttach(file tg.Inputtable) *Data {
 	return d.addFile(field, f.AsAttachment(field))
 }
 
-func (d *Data) writeMultipart() (string, io.Reader) {
+func (d *Data) writeMultipart(ctx context.Context) (string, io.Reader) {
 	r, w := io.Pipe()
 	mp := multipart.NewWriter(w)
 	go func() {
+		// abort the pipe promptly even if the file reading is blocked.
+		stop := context.AfterFunc(ctx, func() {
+			w.CloseWithError(ctx.Err())
+		})
+		defer stop()
 		defer func() {
 			w.CloseWithError(mp.Close())
 		}()
@@ -241,7 +293,7 @@ func (d *Data) writeMultipart() (string, io.Reader) {
 			if reader == nil {
 				continue
 			}
-			_, err = io.Copy(part, reader)
+			_, err = io.Copy(part, &fileReader{ctx: ctx, r: reader, f: file})
 			if err != nil {
 				w.CloseWithError(err)
 				return
@@ -290,89 +342,164 @@ func (d *Data) AddObject(o any, structTagName ...string) *Data {
 	if val.Kind() == reflect.Ptr {
 		val = val.Elem()
 	}
-	typ := val.Type()
-	if typ.Kind() != reflect.Struct {
+	if val.Kind() != reflect.Struct {
 		panic("not a struct")
 	}
+	d.addStruct(val, structTag)
+	return d
+}
 
-	for i := 0; i < val.NumField(); i++ {
-		value := val.Field(i)
-		if !value.IsValid() {
-			continue
-		}
-		if value.Kind() == reflect.Ptr {
+func (d *Data) addStruct(val reflect.Value, structTag string) {
+	plan := planOf(val.Type(), structTag)
+	for i := range plan {
+		f := &plan[i]
+		value := val.Field(f.index)
+		if f.ptr {
 			if value.IsNil() {
 				continue
 			}
 			value = value.Elem()
 		}
-		field := typ.Field(i)
-		if !field.IsExported() {
-			continue
-		}
-		tag, ignore := parseTag(field.Tag.Get(structTag))
-		if ignore {
-			continue
-		}
-		if field.Anonymous && tag.name == "" {
-			anon := structTag
-			if tag.has("json") {
-				anon = "json"
+		if f.anon != "" {
+			switch {
+			case f.marshaler:
+				value.Interface().(Marshaler).MarshalTg(d)
+			case f.kind == reflect.Struct:
+				d.addStruct(value, f.anon)
+			default:
+				panic("not a struct")
 			}
-			d.AddObject(value.Interface(), anon)
 			continue
 		}
-		if tag.name == "" {
-			tag.name = camelToSnake(field.Name)
-		}
-		force := tag.has("force")
-		typ := value.Type()
-		switch typ.Kind() {
+		switch f.kind {
 		case reflect.String:
-			d.Set(tag.name, value.String(), force)
+			d.Set(f.name, value.String(), f.force)
 		case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
-			d.SetInt64(tag.name, value.Int(), force)
+			d.SetInt64(f.name, value.Int(), f.force)
 		case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
-			d.SetUint64(tag.name, value.Uint(), force)
+			d.SetUint64(f.name, value.Uint(), f.force)
 		case reflect.Float32, reflect.Float64:
-			d.SetFloat64(tag.name, value.Float(), force)
+			d.SetFloat64(f.name, value.Float(), f.force)
 		case reflect.Bool:
-			d.SetBool(tag.name, value.Bool(), force)
+			d.SetBool(f.name, value.Bool(), f.force)
 		case reflect.Struct, reflect.Interface:
-			marshalType(d, typ, tag.name, value.Interface())
+			marshalType(d, f, value.Interface())
 		case reflect.Slice, reflect.Array:
-			marshalTypeArray(d, typ, tag.name, value)
+			marshalTypeArray(d, f, value)
 		default:
-			panic("unsupported type " + field.Type.String())
+			panic("unsupported type " + f.typ.String())
 		}
 	}
-	return d
 }
 
-func marshalType(dst *Data, typ reflect.Type, name string, val any) {
-	if typ.Implements(inputtableType) {
-		dst.SetFile(name, val.(tg.Inputtable))
-	} else if typ.Implements(inputterType) {
-		dst.SetInput(name, val.(tg.Inputter))
+func marshalType(dst *Data, f *fieldPlan, val any) {
+	if id, ok := val.(tg.Username); ok {
+		dst.Set(f.name, string(id))
+	} else if f.inputtable {
+		dst.SetFile(f.name, val.(tg.Inputtable))
+	} else if f.inputter {
+		dst.SetInput(f.name, val.(tg.Inputter))
 	} else {
-		dst.SetJSON(name, val)
+		dst.SetJSON(f.name, val)
 	}
 }
 
-func marshalTypeArray(dst *Data, typ reflect.Type, name string, val reflect.Value) {
-	if typ.Elem().Implements(inputtableType) {
+func marshalTypeArray(dst *Data, f *fieldPlan, val reflect.Value) {
+	if f.inputtable {
 		for i := 0; i < val.Len(); i++ {
 			dst.AddAttach(val.Index(i).Interface().(tg.Inputtable))
 		}
-	} else if typ.Elem().Implements(inputterType) {
+	} else if f.inputter {
 		for i := 0; i < val.Len(); i++ {
-			inp := val.Index(i).Interface().(tg.Inputter).GetInput()
-			for _, i := range inp {
+			v := val.Index(i).Interface().(tg.Inputter)
+			for _, i := range v.GetInput() {
 				dst.AddAttach(i)
 			}
+			dst.markPhotos(v)
+		}
+	}
+	dst.SetJSON(f.name, val.Interface())
+}
+
+// fieldPlan describes how the struct field is added to the Data.
+type fieldPlan struct {
+	index int
+	name  string
+	typ   reflect.Type
+	kind  reflect.Kind
+	ptr   bool
+	force bool
+
+	// anon is the struct tag key of the embedded struct.
+	anon      string
+	marshaler bool
+
+	// the type or the element type implements the interface.
+	inputtable bool
+	inputter   bool
+}
+
+type planKey struct {
+	typ reflect.Type
+	tag string
+}
+
+// plans caches the field plans for each struct type and tag key,
+// so the tags are parsed only once per type.
+var plans sync.Map // map[planKey][]fieldPlan
+
+func planOf(typ reflect.Type, structTag string) []fieldPlan {
+	key := planKey{typ, structTag}
+	if p, ok := plans.Load(key); ok {
+		return p.([]fieldPlan)
+	}
+	p, _ := plans.LoadOrStore(key, makePlan(typ, structTag))
+	return p.([]fieldPlan)
+}
+
+func makePlan(typ reflect.Type, structTag string) []fieldPlan {
+	plan := make([]fieldPlan, 0, typ.NumField())
+	for i := 0; i < typ.NumField(); i++ {
+		field := typ.Field(i)
+		if !field.IsExported() {
+			continue
+		}
+		tag, ignore := parseTag(field.Tag.Get(structTag))
+		if ignore {
+			continue
+		}
+		f := fieldPlan{
+			index: i,
+			name:  tag.name,
+			typ:   field.Type,
+			force: tag.has("force"),
+		}
+		t := field.Type
+		if t.Kind() == reflect.Ptr {
+			f.ptr = true
+			t = t.Elem()
+		}
+		f.kind = t.Kind()
+		if field.Anonymous && tag.name == "" {
+			f.anon = structTag
+			if tag.has("json") {
+				f.anon = "json"
+			}
+			f.marshaler = t.Implements(marshalerType)
+			plan = append(plan, f)
+			continue
+		}
+		if f.name == "" {
+			f.name = camelToSnake(field.Name)
+		}
+		if f.kind == reflect.Slice || f.kind == reflect.Array {
+			t = t.Elem()
 		}
+		f.inputtable = t.Implements(inputtableType)
+		f.inputter = t.Implements(inputterType)
+		plan = append(plan, f)
 	}
-	dst.SetJSON(name, val.Interface())
+	return plan
 }
 
 func camelToSnake(s string) string {
@@ -390,6 +517,7 @@ func camelToSnake(s string) string {
 var (
 	inputterType   = reflect.TypeOf((*tg.Inputter)(nil)).Elem()
 	inputtableType = reflect.TypeOf((*tg.Inputtable)(nil)).Elem()
+	marshalerType  = reflect.TypeOf((*Marshaler)(nil)).Elem()
 )
 
 func parseTag(t string) (s structtag, i bool) {
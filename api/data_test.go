@@ -1,7 +1,14 @@
 package api_test
 
 import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"strings"
 	"testing"
+	"time"
 
 	"github.com/karalef/tgot/api"
 	"github.com/karalef/tgot/api/tg"
@@ -60,3 +67,205 @@ func TestMarshal(t *testing.T) {
 		t.Logf("%s: %s\n", k, v.Name)
 	}
 }
+
+func TestMarshalChatID(t *testing.T) {
+	type params struct {
+		ChatID tg.ChatID `tg:"chat_id"`
+	}
+	for id, expected := range map[tg.ChatID]string{
+		tg.ID(-100123):       "-100123",
+		tg.Username("@chan"): "@chan",
+	} {
+		d := api.NewDataFrom(params{ChatID: id})
+		if v := d.Params["chat_id"]; v != expected {
+			t.Errorf("expected %q, got %q", expected, v)
+		}
+		d.Put()
+	}
+}
+
+type sendMessage struct {
+	ChatID      tg.ChatID                `tg:"chat_id"`
+	Text        string                   `tg:"text"`
+	Entities    []tg.MessageEntity       `tg:"entities"`
+	Silent      bool                     `tg:"disable_notification"`
+	ReplyMarkup *tg.InlineKeyboardMarkup `tg:"reply_markup"`
+}
+
+var testMessage = sendMessage{
+	ChatID: tg.ID(123),
+	Text:   "multi\nline \"text\"   with \x01 control",
+	Entities: []tg.MessageEntity{
+		{Type: tg.EntityBold, Offset: 0, Length: 5},
+	},
+	Silent: true,
+	ReplyMarkup: &tg.InlineKeyboardMarkup{
+		Keyboard: [][]tg.InlineKeyboardButton{{{Text: "button", CallbackData: "data"}}},
+	},
+}
+
+func TestEncodeJSON(t *testing.T) {
+	d := api.NewDataFrom(testMessage)
+	defer d.Put()
+	d.Set("empty", "", true)
+	d.SetJSON("photo", 1).SetFile("photo", tg.FileID("file-id"))
+
+	ctype, r := d.Encode(context.Background(), api.EncodingJSON)
+	if ctype != "application/json" {
+		t.Fatalf("unexpected content type %s", ctype)
+	}
+	var body struct {
+		ChatID      int64                    `json:"chat_id"`
+		Text        string                   `json:"text"`
+		Entities    []tg.MessageEntity       `json:"entities"`
+		Silent      bool                     `json:"disable_notification"`
+		ReplyMarkup *tg.InlineKeyboardMarkup `json:"reply_markup"`
+		Empty       *string                  `json:"empty"`
+		Photo       string                   `json:"photo"`
+	}
+	if err := json.NewDecoder(r).Decode(&body); err != nil {
+		t.Fatal(err)
+	}
+	switch {
+	case body.ChatID != 123, body.Text != testMessage.Text, !body.Silent,
+		len(body.Entities) != 1 || body.Entities[0] != testMessage.Entities[0],
+		body.ReplyMarkup == nil || body.ReplyMarkup.Keyboard[0][0].CallbackData != "data",
+		body.Empty == nil || *body.Empty != "", body.Photo != "file-id":
+		t.Fatalf("unexpected body %+v", body)
+	}
+}
+
+func TestEncodeJSONUpload(t *testing.T) {
+	d := api.NewData().SetFile("photo", tg.FileBytes("photo", []byte{1}))
+	defer d.Put()
+	ctype, r := d.Encode(context.Background(), api.EncodingJSON)
+	if !strings.HasPrefix(ctype, "multipart/form-data") {
+		t.Fatalf("expected multipart, got %s", ctype)
+	}
+	io.Copy(io.Discard, r)
+}
+
+func benchmarkEncode(b *testing.B, enc api.Encoding) {
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		d := api.NewDataFrom(testMessage)
+		_, r := d.Encode(context.Background(), enc)
+		io.Copy(io.Discard, r)
+		d.Put()
+	}
+}
+
+func BenchmarkEncodeForm(b *testing.B) { benchmarkEncode(b, api.EncodingForm) }
+func BenchmarkEncodeJSON(b *testing.B) { benchmarkEncode(b, api.EncodingJSON) }
+
+type planType struct {
+	*Embed
+	Struct     Struct
+	Ignored    string `tg:"-"`
+	Zero       int    `tg:"zero,force"`
+	CamelCase  bool
+	unexported int
+}
+
+func TestAddObjectPlan(t *testing.T) {
+	for i := 0; i < 2; i++ {
+		d := api.NewDataFrom(&planType{CamelCase: true, unexported: 1})
+		expected := map[string]string{
+			"zero":       "0",
+			"Camel_case": "true",
+			"Struct":     `{"struct_val":0}`,
+		}
+		if len(d.Params) != len(expected) {
+			t.Fatalf("expected %v, got %v", expected, d.Params)
+		}
+		for k, v := range expected {
+			if d.Params[k] != v {
+				t.Fatalf("expected %v, got %v", expected, d.Params)
+			}
+		}
+		d.Put()
+	}
+}
+
+func TestCheckLimits(t *testing.T) {
+	limits := api.Limits{Photo: 10, File: 20}
+	photo := tg.FileBytes("photo", make([]byte, 15))
+	doc := tg.FileBytes("doc", make([]byte, 15))
+
+	d := api.NewData().SetFile("document", doc)
+	if err := d.CheckLimits(limits); err != nil {
+		t.Fatal(err)
+	}
+	d.SetFile("photo", photo)
+	var tooLarge *api.FileTooLargeError
+	if err := d.CheckLimits(limits); !errors.As(err, &tooLarge) || tooLarge.Field != "photo" {
+		t.Fatalf("expected photo to be too large, got %v", err)
+	}
+	d.Put()
+
+	d = api.NewData().SetInput("media", tg.InputMedia{
+		Media: photo,
+		Data:  &tg.InputMediaPhoto{},
+	})
+	if err := d.CheckLimits(limits); !errors.As(err, &tooLarge) || tooLarge.Limit != 10 {
+		t.Fatalf("expected media photo to be too large, got %v", err)
+	}
+	d.Put()
+
+	d = api.NewDataFrom(struct {
+		Media []tg.InputMedia `tg:"media"`
+	}{[]tg.InputMedia{
+		{Media: doc, Data: &tg.InputMediaDocument{}},
+		{Media: photo, Data: &tg.InputMediaPhoto{}},
+	}})
+	if err := d.CheckLimits(limits); !errors.As(err, &tooLarge) || tooLarge.Limit != 10 {
+		t.Fatalf("expected media group photo to be too large, got %v", err)
+	}
+	d.Put()
+}
+
+func TestUploadProgress(t *testing.T) {
+	var sent, total int64
+	f := tg.FileReader("file", bytes.NewReader(make([]byte, 100<<10))).
+		WithProgress(func(s, t int64) { sent, total = s, t })
+	d := api.NewData().SetFile("document", f)
+	defer d.Put()
+
+	_, r := d.Encode(context.Background(), api.EncodingForm)
+	if _, err := io.Copy(io.Discard, r); err != nil {
+		t.Fatal(err)
+	}
+	if sent != 100<<10 || total != 100<<10 {
+		t.Fatalf("unexpected progress %d/%d", sent, total)
+	}
+}
+
+type blockingReader chan struct{}
+
+func (r blockingReader) Read([]byte) (int, error) {
+	<-r
+	return 0, io.EOF
+}
+
+func TestUploadCancel(t *testing.T) {
+	block := make(blockingReader)
+	defer close(block)
+	d := api.NewData().SetFile("document", tg.FileReader("file", block))
+
+	ctx, cancel := context.WithCancel(context.Background())
+	_, r := d.Encode(ctx, api.EncodingForm)
+	done := make(chan error)
+	go func() {
+		_, err := io.Copy(io.Discard, r)
+		done <- err
+	}()
+	cancel()
+	select {
+	case err := <-done:
+		if !errors.Is(err, context.Canceled) {
+			t.Fatalf("expected context.Canceled, got %v", err)
+		}
+	case <-time.After(time.Second):
+		t.Fatal("upload is not aborted")
+	}
+}
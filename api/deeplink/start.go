@@ -0,0 +1,91 @@
+package deeplink
+
+import (
+	"encoding/base64"
+	"errors"
+	"net/url"
+	"strings"
+)
+
+// MaxStartLength is the maximum length of the start parameter.
+const MaxStartLength = 64
+
+// start parameter errors.
+var (
+	ErrStartTooLong = errors.New("deeplink: start parameter is too long")
+	ErrStartPrefix  = errors.New("deeplink: invalid start prefix")
+	ErrStartArg     = errors.New("deeplink: start argument contains a new line")
+)
+
+// EncodeStart encodes the prefix and the arguments into the start parameter.
+//
+// The prefix can contain only latin letters and digits. The arguments are
+// joined with a new line and encoded using unpadded base64url, so the
+// parameter has the format 'prefix_data' or just 'prefix' if there are no
+// arguments.
+func EncodeStart(prefix string, args ...string) (string, error) {
+	if !validPrefix(prefix) {
+		return "", ErrStartPrefix
+	}
+	param := prefix
+	if len(args) > 0 {
+		for _, a := range args {
+			if strings.Contains(a, "\n") {
+				return "", ErrStartArg
+			}
+		}
+		param += "_" + base64.RawURLEncoding.EncodeToString([]byte(strings.Join(args, "\n")))
+	}
+	if len(param) > MaxStartLength {
+		return "", ErrStartTooLong
+	}
+	return param, nil
+}
+
+// DecodeStart decodes the start parameter encoded by EncodeStart.
+func DecodeStart(param string) (prefix string, args []string, err error) {
+	if len(param) > MaxStartLength {
+		return "", nil, ErrStartTooLong
+	}
+	prefix, data, ok := strings.Cut(param, "_")
+	if !validPrefix(prefix) {
+		return "", nil, ErrStartPrefix
+	}
+	if !ok {
+		return prefix, nil, nil
+	}
+	b, err := base64.RawURLEncoding.DecodeString(data)
+	if err != nil {
+		return "", nil, err
+	}
+	return prefix, strings.Split(string(b), "\n"), nil
+}
+
+func validPrefix(prefix string) bool {
+	if prefix == "" {
+		return false
+	}
+	for _, c := range prefix {
+		if (c < 'a' || c > 'z') && (c < 'A' || c > 'Z') && (c < '0' || c > '9') {
+			return false
+		}
+	}
+	return true
+}
+
+// Start returns 'https://t.me/bot?start=param' deeplink.
+func Start(bot, param string) string {
+	return HTTPS(bot, url.Values{"start": {param}})
+}
+
+// StartGroup returns 'https://t.me/bot?startgroup=param' deeplink that adds
+// the bot to a group.
+func StartGroup(bot, param string) string {
+	return HTTPS(bot, url.Values{"startgroup": {param}})
+}
+
+// StartAttach returns 'https://t.me/bot?startattach=param' deeplink that
+// opens the attachment menu.
+func StartAttach(bot, param string) string {
+	return HTTPS(bot, url.Values{"startattach": {param}})
+}
@@ -0,0 +1,31 @@
+package deeplink
+
+import (
+	"slices"
+	"strings"
+	"testing"
+)
+
+func TestStart(t *testing.T) {
+	param, err := EncodeStart("ref", "123", "", "hello world")
+	if err != nil {
+		t.Fatal(err)
+	}
+	prefix, args, err := DecodeStart(param)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if prefix != "ref" || !slices.Equal(args, []string{"123", "", "hello world"}) {
+		t.Fatalf("unexpected result %q %q", prefix, args)
+	}
+
+	if prefix, args, err = DecodeStart("promo"); err != nil || prefix != "promo" || args != nil {
+		t.Fatalf("unexpected result %q %q %v", prefix, args, err)
+	}
+	if _, err = EncodeStart("ref", strings.Repeat("a", 60)); err != ErrStartTooLong {
+		t.Fatalf("expected ErrStartTooLong, got %v", err)
+	}
+	if _, err = EncodeStart("re_f"); err != ErrStartPrefix {
+		t.Fatalf("expected ErrStartPrefix, got %v", err)
+	}
+}
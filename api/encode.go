@@ -0,0 +1,93 @@
+package api
+
+import (
+	"strconv"
+	"unicode/utf8"
+)
+
+// Encoding represents the request body encoding.
+type Encoding uint8
+
+// all available encodings.
+const (
+	// EncodingForm encodes the params as “URL encoded” form.
+	// The nested objects are sent as JSON strings.
+	EncodingForm Encoding = iota
+
+	// EncodingJSON encodes the params as a single JSON object.
+	// The nested objects, numbers and booleans are embedded as is.
+	EncodingJSON
+)
+
+func (d *Data) appendJSON(b []byte) []byte {
+	b = append(b, '{')
+	first := true
+	for k, v := range d.Params {
+		if !first {
+			b = append(b, ',')
+		}
+		first = false
+		b = appendJSONString(b, k)
+		b = append(b, ':')
+		if _, raw := d.raw[k]; raw {
+			b = append(b, v...)
+		} else {
+			b = appendJSONString(b, v)
+		}
+	}
+	return append(b, '}')
+}
+
+const hex = "0123456789abcdef"
+
+// appendJSONString appends the JSON string literal as encoding/json does
+// without the HTML escaping.
+func appendJSONString(b []byte, s string) []byte {
+	b = append(b, '"')
+	start := 0
+	for i := 0; i < len(s); {
+		c := s[i]
+		if c < utf8.RuneSelf {
+			if c >= 0x20 && c != '"' && c != '\\' {
+				i++
+				continue
+			}
+			b = append(b, s[start:i]...)
+			switch c {
+			case '"', '\\':
+				b = append(b, '\\', c)
+			case '\n':
+				b = append(b, '\\', 'n')
+			case '\r':
+				b = append(b, '\\', 'r')
+			case '\t':
+				b = append(b, '\\', 't')
+			default:
+				b = append(b, '\\', 'u', '0', '0', hex[c>>4], hex[c&0xF])
+			}
+			i++
+			start = i
+			continue
+		}
+		r, size := utf8.DecodeRuneInString(s[i:])
+		if r == utf8.RuneError && size == 1 {
+			b = append(b, s[start:i]...)
+			b = append(b, `\ufffd`...)
+			i += size
+			start = i
+			continue
+		}
+		// U+2028 and U+2029 are valid JSON but break JavaScript.
+		if r == '\u2028' || r == '\u2029' {
+			b = append(b, s[start:i]...)
+			b = append(b, `\u202`...)
+			b = strconv.AppendInt(b, int64(r&0xF), 16)
+			i += size
+			start = i
+			continue
+		}
+		i += size
+	}
+	b = append(b, s[start:]...)
+	return append(b, '"')
+}
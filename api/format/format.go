@@ -0,0 +1,253 @@
+// Package format converts the text with entities to the HTML, MarkdownV2 and
+// legacy Markdown markup and back.
+//
+// The entity offsets and lengths are in UTF-16 code units as in the Bot API.
+package format
+
+import (
+	"cmp"
+	"errors"
+	"slices"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/karalef/tgot/api/tg"
+)
+
+// ErrParseMode is returned for unknown parse modes.
+var ErrParseMode = errors.New("format: unknown parse mode")
+
+// SyntaxError is returned when the markup is invalid.
+type SyntaxError struct {
+	Mode tg.ParseMode
+
+	// Offset is the byte offset in the markup.
+	Offset int
+	Msg    string
+}
+
+func (e *SyntaxError) Error() string {
+	return "format: " + string(e.Mode) + ": " + e.Msg + " at offset " + strconv.Itoa(e.Offset)
+}
+
+// Escape escapes the text for the parse mode.
+func Escape(mode tg.ParseMode, s string) string {
+	switch mode {
+	case tg.HTML:
+		return EscapeHTML(s)
+	case tg.MarkdownV2:
+		return EscapeMarkdownV2(s)
+	case tg.Markdown:
+		return EscapeMarkdown(s)
+	}
+	return s
+}
+
+// Render converts the text with entities to the markup.
+// The entities that are not supported by the parse mode are omitted.
+func Render(mode tg.ParseMode, text string, entities []tg.MessageEntity) (string, error) {
+	switch mode {
+	case tg.HTML:
+		return HTML(text, entities), nil
+	case tg.MarkdownV2:
+		return MarkdownV2(text, entities), nil
+	case tg.Markdown:
+		return Markdown(text, entities), nil
+	}
+	return "", ErrParseMode
+}
+
+// Parse parses the markup into the text with entities.
+func Parse(mode tg.ParseMode, s string) (string, []tg.MessageEntity, error) {
+	switch mode {
+	case tg.HTML:
+		return ParseHTML(s)
+	case tg.MarkdownV2:
+		return ParseMarkdownV2(s)
+	case tg.Markdown:
+		return ParseMarkdown(s)
+	}
+	return "", nil, ErrParseMode
+}
+
+// user and custom emoji links.
+const (
+	userLink  = "tg://user?id="
+	emojiLink = "tg://emoji?id="
+)
+
+func userURL(u *tg.User) string {
+	if u == nil {
+		return userLink + "0"
+	}
+	return userLink + strconv.FormatInt(int64(u.ID), 10)
+}
+
+// linkEntity makes a text link, text mention or custom emoji entity.
+func linkEntity(url string) tg.MessageEntity {
+	if id, ok := strings.CutPrefix(url, userLink); ok {
+		if n, err := strconv.ParseInt(id, 10, 64); err == nil {
+			return tg.MessageEntity{Type: tg.EntityTextMention, User: &tg.User{ID: tg.ID(n)}}
+		}
+	}
+	if id, ok := strings.CutPrefix(url, emojiLink); ok {
+		return tg.MessageEntity{Type: tg.EntityCustomEmoji, CustomEmojiID: id}
+	}
+	return tg.MessageEntity{Type: tg.EntityTextLink, URL: url}
+}
+
+func isCode(t tg.EntityType) bool {
+	return t == tg.EntityCode || t == tg.EntityCodeBlock
+}
+
+func isQuote(t tg.EntityType) bool {
+	return t == tg.EntityBlockquote || t == tg.EntityExpandableBlockQuote
+}
+
+// sortEntities sorts the entities by offset with the outer entities first.
+func sortEntities(entities []tg.MessageEntity) {
+	slices.SortStableFunc(entities, func(a, b tg.MessageEntity) int {
+		if c := cmp.Compare(a.Offset, b.Offset); c != 0 {
+			return c
+		}
+		return cmp.Compare(b.Length, a.Length)
+	})
+}
+
+// merge sorts the entities and merges the overlapping and adjacent entities
+// with the same attributes, since the markup can't express them.
+func merge(entities []tg.MessageEntity) []tg.MessageEntity {
+	sortEntities(entities)
+	res := entities[:0]
+	for _, e := range entities {
+		i := slices.IndexFunc(res, func(o tg.MessageEntity) bool {
+			return sameEntity(o, e) && e.Offset <= o.Offset+o.Length
+		})
+		if i == -1 {
+			res = append(res, e)
+			continue
+		}
+		res[i].Length = max(res[i].Length, e.Offset+e.Length-res[i].Offset)
+	}
+	return res
+}
+
+func sameEntity(a, b tg.MessageEntity) bool {
+	if a.Type != b.Type || a.URL != b.URL || a.Language != b.Language ||
+		a.CustomEmojiID != b.CustomEmojiID || (a.User == nil) != (b.User == nil) {
+		return false
+	}
+	return a.User == nil || a.User.ID == b.User.ID
+}
+
+// renderer writes the markup.
+type renderer interface {
+	supports(t tg.EntityType) bool
+	open(e *tg.MessageEntity)
+	close(e *tg.MessageEntity)
+	text(s string)
+}
+
+// walk calls the renderer for the text and entities. The overlapping entities
+// are split so that the markup is properly nested. The entities starting
+// inside the code and pre entities are omitted. If nest is false, all the
+// entities starting inside another entity are omitted.
+func walk(text string, entities []tg.MessageEntity, nest bool, r renderer) {
+	ents := make([]tg.MessageEntity, 0, len(entities))
+	for _, e := range entities {
+		if e.Length > 0 && r.supports(e.Type) {
+			ents = append(ents, e)
+		}
+	}
+	ents = merge(ents)
+
+	var stack []*tg.MessageEntity
+	end := func(e *tg.MessageEntity) int { return e.Offset + e.Length }
+	next, start, pos := 0, 0, 0
+	step := func(b int) {
+		// close the entities ending at pos
+		for {
+			i := slices.IndexFunc(stack, func(e *tg.MessageEntity) bool { return end(e) <= pos })
+			if i == -1 {
+				break
+			}
+			if start < b {
+				r.text(text[start:b])
+				start = b
+			}
+			reopen := slices.Clone(stack[i+1:])
+			for j := len(stack) - 1; j >= i; j-- {
+				r.close(stack[j])
+			}
+			stack = stack[:i]
+			for _, e := range reopen {
+				if end(e) > pos {
+					r.open(e)
+					stack = append(stack, e)
+				}
+			}
+		}
+		// open the entities starting at pos
+		for ; next < len(ents) && ents[next].Offset <= pos; next++ {
+			e := &ents[next]
+			if end(e) <= pos || (!nest && len(stack) > 0) ||
+				slices.ContainsFunc(stack, func(e *tg.MessageEntity) bool { return isCode(e.Type) }) {
+				continue
+			}
+			if start < b {
+				r.text(text[start:b])
+				start = b
+			}
+			r.open(e)
+			stack = append(stack, e)
+		}
+	}
+
+	for b, c := range text {
+		step(b)
+		pos += tg.UTF16RuneLen(c)
+	}
+	step(len(text))
+	if start < len(text) {
+		r.text(text[start:])
+	}
+	for j := len(stack) - 1; j >= 0; j-- {
+		r.close(stack[j])
+	}
+}
+
+// parser builds the text with entities.
+type parser struct {
+	mode     tg.ParseMode
+	sb       strings.Builder
+	pos      int
+	entities []tg.MessageEntity
+}
+
+func (p *parser) write(s string) {
+	p.sb.WriteString(s)
+	p.pos += tg.UTF16Len(s)
+}
+
+func (p *parser) writeRune(c rune) {
+	var b [utf8.UTFMax]byte
+	p.write(string(b[:utf8.EncodeRune(b[:], c)]))
+}
+
+// add adds the entity that starts at offset and ends at the current position.
+func (p *parser) add(e tg.MessageEntity, offset int) {
+	e.Offset, e.Length = offset, p.pos-offset
+	if e.Length > 0 {
+		p.entities = append(p.entities, e)
+	}
+}
+
+func (p *parser) result() (string, []tg.MessageEntity, error) {
+	sortEntities(p.entities)
+	return p.sb.String(), p.entities, nil
+}
+
+func (p *parser) errorf(offset int, msg string) error {
+	return &SyntaxError{Mode: p.mode, Offset: offset, Msg: msg}
+}
@@ -0,0 +1,218 @@
+package format
+
+import (
+	"fmt"
+	"math/rand/v2"
+	"slices"
+	"strings"
+	"testing"
+	"unicode/utf16"
+
+	"github.com/karalef/tgot/api/tg"
+)
+
+func TestEscape(t *testing.T) {
+	tests := []struct {
+		mode     tg.ParseMode
+		in, want string
+	}{
+		{tg.HTML, `<a href="x">&</a>`, "&lt;a href=&quot;x&quot;&gt;&amp;&lt;/a&gt;"},
+		{tg.MarkdownV2, "1+1=2. (ok) _*~`>#|{}!\\", `1\+1\=2\. \(ok\) \_\*\~\` + "`" + `\>\#\|\{\}\!\\`},
+		{tg.Markdown, "snake_case *a* `b` [c]", `snake\_case \*a\* \` + "`b\\`" + ` \[c]`},
+	}
+	for _, test := range tests {
+		got := Escape(test.mode, test.in)
+		if got != test.want {
+			t.Errorf("%s: expected %q, got %q", test.mode, test.want, got)
+		}
+		text, entities, err := Parse(test.mode, got)
+		if err != nil || text != test.in || len(entities) != 0 {
+			t.Errorf("%s: unexpected parse result %q %v %v", test.mode, text, entities, err)
+		}
+	}
+}
+
+func TestRender(t *testing.T) {
+	text := "bold italic code\nquote line\nnext"
+	entities := []tg.MessageEntity{
+		{Type: tg.EntityBold, Offset: 0, Length: 11},
+		{Type: tg.EntityItalic, Offset: 5, Length: 6},
+		{Type: tg.EntityCodeBlock, Offset: 12, Length: 4, Language: "go"},
+		{Type: tg.EntityExpandableBlockQuote, Offset: 17, Length: 15},
+	}
+	tests := []struct {
+		mode tg.ParseMode
+		want string
+	}{
+		{tg.HTML, `<b>bold <i>italic</i></b> <pre><code class="language-go">code</code></pre>` +
+			"\n<blockquote expandable>quote line\nnext</blockquote>"},
+		{tg.MarkdownV2, "*bold _italic_* ```go\ncode```\n**>quote line\n>next||"},
+		{tg.Markdown, "*bold italic* ```go\ncode```\nquote line\nnext"},
+	}
+	for _, test := range tests {
+		got, err := Render(test.mode, text, entities)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got != test.want {
+			t.Errorf("%s: expected %q, got %q", test.mode, test.want, got)
+		}
+	}
+
+	// the italic and underline delimiters are separated
+	got := MarkdownV2("ab", []tg.MessageEntity{
+		{Type: tg.EntityItalic, Offset: 0, Length: 2},
+		{Type: tg.EntityUnderline, Offset: 0, Length: 2},
+	})
+	if got != "_\r__ab__\r_" {
+		t.Errorf("unexpected italic underline markup %q", got)
+	}
+}
+
+func TestParseMarkdownV2Quote(t *testing.T) {
+	text, entities, err := ParseMarkdownV2(">first\n>second\nplain\n**>hidden *bold*||")
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := []tg.MessageEntity{
+		{Type: tg.EntityBlockquote, Offset: 0, Length: 12},
+		{Type: tg.EntityExpandableBlockQuote, Offset: 19, Length: 11},
+		{Type: tg.EntityBold, Offset: 26, Length: 4},
+	}
+	if text != "first\nsecond\nplain\nhidden bold" || !slices.Equal(entities, want) {
+		t.Fatalf("unexpected result %q %+v", text, entities)
+	}
+}
+
+// the property tests render random texts with random entities and check that
+// the parsed entities cover the same characters.
+
+var (
+	alphabet = []rune("ab cd\n_*[]()~`>#+-=|{}.!\\<&\"';😀жё")
+	plain    = []rune("ab cd\nжё😀")
+	urls     = []string{"https://example.com/a_(b)?c=d&e=\"f\"", "http://t.me/x"}
+
+	// the legacy Markdown can't escape the parenthesis in the url
+	legacyURLs = []string{"https://example.com/a_b?c=d&e=\"f\"", "http://t.me/x"}
+)
+
+type entityGen struct {
+	types []tg.EntityType
+	nest  bool
+	alpha []rune
+	urls  []string
+}
+
+func (g entityGen) generate(r *rand.Rand) (string, []tg.MessageEntity) {
+	runes := make([]rune, r.IntN(30)+1)
+	for i := range runes {
+		runes[i] = g.alpha[r.IntN(len(g.alpha))]
+	}
+	text := string(runes)
+
+	// the UTF-16 rune boundaries
+	bounds := []int{0}
+	for _, c := range runes {
+		bounds = append(bounds, bounds[len(bounds)-1]+len(utf16.Encode([]rune{c})))
+	}
+
+	var entities []tg.MessageEntity
+	intersects := func(a, b tg.MessageEntity) bool {
+		return a.Offset < b.Offset+b.Length && b.Offset < a.Offset+a.Length
+	}
+	for range r.IntN(6) {
+		i := r.IntN(len(bounds) - 1)
+		j := i + 1 + r.IntN(len(bounds)-i-1)
+		e := tg.MessageEntity{
+			Type:   g.types[r.IntN(len(g.types))],
+			Offset: bounds[i],
+			Length: bounds[j] - bounds[i],
+		}
+		switch e.Type {
+		case tg.EntityTextLink:
+			e.URL = g.urls[r.IntN(len(g.urls))]
+		case tg.EntityTextMention:
+			e.User = &tg.User{ID: tg.ID(r.IntN(1000))}
+		case tg.EntityCustomEmoji:
+			e.CustomEmojiID = fmt.Sprint(r.IntN(1000))
+		case tg.EntityCodeBlock:
+			e.Language = []string{"", "go"}[r.IntN(2)]
+		}
+		// code can't contain other entities and quotes can't be nested
+		conflict := slices.ContainsFunc(entities, func(o tg.MessageEntity) bool {
+			return intersects(e, o) && (!g.nest || isCode(e.Type) || isCode(o.Type) ||
+				(isQuote(e.Type) && isQuote(o.Type)))
+		})
+		if !conflict {
+			entities = append(entities, e)
+		}
+	}
+	return text, entities
+}
+
+// coverage returns the set of the entity keys for each UTF-16 code unit.
+func coverage(text string, entities []tg.MessageEntity) []string {
+	n := len(utf16.Encode([]rune(text)))
+	cov := make([][]string, n)
+	for _, e := range entities {
+		key := string(e.Type) + "|" + e.URL + "|" + e.Language + "|" + e.CustomEmojiID
+		if e.User != nil {
+			key += fmt.Sprint("|", e.User.ID)
+		}
+		for i := e.Offset; i < e.Offset+e.Length && i < n; i++ {
+			if !slices.Contains(cov[i], key) {
+				cov[i] = append(cov[i], key)
+			}
+		}
+	}
+	res := make([]string, n)
+	for i := range cov {
+		slices.Sort(cov[i])
+		res[i] = strings.Join(cov[i], ",")
+	}
+	return res
+}
+
+func roundTrip(t *testing.T, mode tg.ParseMode, g entityGen) {
+	r := rand.New(rand.NewPCG(1, uint64(len(mode))))
+	for range 5000 {
+		text, entities := g.generate(r)
+		markup, err := Render(mode, text, entities)
+		if err != nil {
+			t.Fatal(err)
+		}
+		parsed, parsedEntities, err := Parse(mode, markup)
+		if err != nil {
+			t.Fatalf("%q %+v\nmarkup %q\n%v", text, entities, markup, err)
+		}
+		if parsed != text {
+			t.Fatalf("%q %+v\nmarkup %q\nexpected text %q, got %q", text, entities, markup, text, parsed)
+		}
+		if want, got := coverage(text, entities), coverage(parsed, parsedEntities); !slices.Equal(want, got) {
+			t.Fatalf("%q %+v\nmarkup %q\nexpected %q\ngot %q", text, entities, markup, want, got)
+		}
+	}
+}
+
+var nestable = []tg.EntityType{
+	tg.EntityBold, tg.EntityItalic, tg.EntityUnderline, tg.EntityStrikethrough,
+	tg.EntitySpoiler, tg.EntityCode, tg.EntityCodeBlock, tg.EntityTextLink,
+	tg.EntityTextMention, tg.EntityCustomEmoji,
+}
+
+func TestHTMLRoundTrip(t *testing.T) {
+	types := append(slices.Clone(nestable), tg.EntityBlockquote, tg.EntityExpandableBlockQuote)
+	roundTrip(t, tg.HTML, entityGen{types: types, nest: true, alpha: alphabet, urls: urls})
+}
+
+func TestMarkdownV2RoundTrip(t *testing.T) {
+	roundTrip(t, tg.MarkdownV2, entityGen{types: nestable, nest: true, alpha: alphabet, urls: urls})
+}
+
+func TestMarkdownRoundTrip(t *testing.T) {
+	types := []tg.EntityType{
+		tg.EntityBold, tg.EntityItalic, tg.EntityCode, tg.EntityCodeBlock,
+		tg.EntityTextLink, tg.EntityTextMention,
+	}
+	roundTrip(t, tg.Markdown, entityGen{types: types, alpha: plain, urls: legacyURLs})
+}
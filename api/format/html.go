@@ -0,0 +1,272 @@
+package format
+
+import (
+	"strconv"
+	"strings"
+
+	"github.com/karalef/tgot/api/tg"
+)
+
+var htmlEscaper = strings.NewReplacer("&", "&amp;", "<", "&lt;", ">", "&gt;", `"`, "&quot;")
+
+// EscapeHTML escapes the text for the HTML parse mode.
+func EscapeHTML(s string) string { return htmlEscaper.Replace(s) }
+
+// HTML converts the text with entities to the HTML markup.
+func HTML(text string, entities []tg.MessageEntity) string {
+	var r htmlRenderer
+	walk(text, entities, true, &r)
+	return r.sb.String()
+}
+
+type htmlRenderer struct {
+	sb strings.Builder
+}
+
+var htmlTags = map[tg.EntityType]string{
+	tg.EntityBold:                 "b",
+	tg.EntityItalic:               "i",
+	tg.EntityUnderline:            "u",
+	tg.EntityStrikethrough:        "s",
+	tg.EntitySpoiler:              "tg-spoiler",
+	tg.EntityCode:                 "code",
+	tg.EntityCodeBlock:            "pre",
+	tg.EntityTextLink:             "a",
+	tg.EntityTextMention:          "a",
+	tg.EntityCustomEmoji:          "tg-emoji",
+	tg.EntityBlockquote:           "blockquote",
+	tg.EntityExpandableBlockQuote: "blockquote",
+}
+
+func (r *htmlRenderer) supports(t tg.EntityType) bool {
+	_, ok := htmlTags[t]
+	return ok
+}
+
+func (r *htmlRenderer) open(e *tg.MessageEntity) {
+	r.sb.WriteString("<" + htmlTags[e.Type])
+	switch e.Type {
+	case tg.EntityTextLink:
+		r.sb.WriteString(` href="` + EscapeHTML(e.URL) + `"`)
+	case tg.EntityTextMention:
+		r.sb.WriteString(` href="` + userURL(e.User) + `"`)
+	case tg.EntityCustomEmoji:
+		r.sb.WriteString(` emoji-id="` + EscapeHTML(e.CustomEmojiID) + `"`)
+	case tg.EntityExpandableBlockQuote:
+		r.sb.WriteString(" expandable")
+	}
+	r.sb.WriteByte('>')
+	if e.Type == tg.EntityCodeBlock && e.Language != "" {
+		r.sb.WriteString(`<code class="language-` + EscapeHTML(e.Language) + `">`)
+	}
+}
+
+func (r *htmlRenderer) close(e *tg.MessageEntity) {
+	if e.Type == tg.EntityCodeBlock && e.Language != "" {
+		r.sb.WriteString("</code>")
+	}
+	r.sb.WriteString("</" + htmlTags[e.Type] + ">")
+}
+
+func (r *htmlRenderer) text(s string) { r.sb.WriteString(EscapeHTML(s)) }
+
+// ParseHTML parses the HTML markup into the text with entities.
+func ParseHTML(s string) (string, []tg.MessageEntity, error) {
+	type open struct {
+		tag    string
+		entity tg.MessageEntity
+		offset int
+
+		// lang is true for the code tag that sets the pre language.
+		lang bool
+	}
+	p := parser{mode: tg.HTML}
+	var stack []open
+
+	for i := 0; i < len(s); {
+		switch s[i] {
+		case '<':
+			end := strings.IndexByte(s[i:], '>')
+			if end == -1 {
+				return "", nil, p.errorf(i, "unclosed tag")
+			}
+			tag := s[i+1 : i+end]
+			if name, ok := strings.CutPrefix(tag, "/"); ok {
+				name = strings.ToLower(strings.TrimSpace(name))
+				if len(stack) == 0 || stack[len(stack)-1].tag != name {
+					return "", nil, p.errorf(i, "unexpected end tag "+name)
+				}
+				o := stack[len(stack)-1]
+				stack = stack[:len(stack)-1]
+				if !o.lang {
+					p.add(o.entity, o.offset)
+				}
+				i += end + 1
+				continue
+			}
+			name, attrs, err := parseTag(tag)
+			if err != nil {
+				return "", nil, p.errorf(i, err.Error())
+			}
+			o := open{tag: name, offset: p.pos}
+			switch name {
+			case "b", "strong":
+				o.entity.Type = tg.EntityBold
+			case "i", "em":
+				o.entity.Type = tg.EntityItalic
+			case "u", "ins":
+				o.entity.Type = tg.EntityUnderline
+			case "s", "strike", "del":
+				o.entity.Type = tg.EntityStrikethrough
+			case "tg-spoiler":
+				o.entity.Type = tg.EntitySpoiler
+			case "span":
+				if attrs["class"] != "tg-spoiler" {
+					return "", nil, p.errorf(i, "unsupported span")
+				}
+				o.entity.Type = tg.EntitySpoiler
+			case "a":
+				o.entity = linkEntity(attrs["href"])
+			case "tg-emoji":
+				o.entity = tg.MessageEntity{Type: tg.EntityCustomEmoji, CustomEmojiID: attrs["emoji-id"]}
+			case "code":
+				o.entity.Type = tg.EntityCode
+				lang, ok := strings.CutPrefix(attrs["class"], "language-")
+				if n := len(stack); ok && n > 0 && stack[n-1].tag == "pre" && stack[n-1].offset == p.pos {
+					stack[n-1].entity.Language = lang
+					o.lang = true
+				}
+			case "pre":
+				o.entity.Type = tg.EntityCodeBlock
+			case "blockquote":
+				o.entity.Type = tg.EntityBlockquote
+				if _, ok := attrs["expandable"]; ok {
+					o.entity.Type = tg.EntityExpandableBlockQuote
+				}
+			default:
+				return "", nil, p.errorf(i, "unsupported tag "+name)
+			}
+			stack = append(stack, o)
+			i += end + 1
+		case '&':
+			end := strings.IndexByte(s[i:], ';')
+			if end == -1 {
+				return "", nil, p.errorf(i, "unterminated character reference")
+			}
+			c, ok := htmlEntity(s[i+1 : i+end])
+			if !ok {
+				return "", nil, p.errorf(i, "unsupported character reference")
+			}
+			p.writeRune(c)
+			i += end + 1
+		default:
+			end := strings.IndexAny(s[i:], "<&")
+			if end == -1 {
+				end = len(s) - i
+			}
+			p.write(s[i : i+end])
+			i += end
+		}
+	}
+	if len(stack) > 0 {
+		return "", nil, p.errorf(len(s), "unclosed tag "+stack[len(stack)-1].tag)
+	}
+	return p.result()
+}
+
+// parseTag parses the tag name and attributes.
+func parseTag(tag string) (string, map[string]string, error) {
+	name, rest, _ := strings.Cut(strings.TrimSpace(tag), " ")
+	attrs := make(map[string]string)
+	for rest = strings.TrimSpace(rest); rest != ""; rest = strings.TrimSpace(rest) {
+		i := strings.IndexAny(rest, "= ")
+		if i == -1 || rest[i] == ' ' {
+			if i == -1 {
+				i = len(rest)
+			}
+			attrs[strings.ToLower(rest[:i])] = ""
+			rest = rest[i:]
+			continue
+		}
+		key := strings.ToLower(rest[:i])
+		rest = strings.TrimSpace(rest[i+1:])
+		var val string
+		if rest != "" && (rest[0] == '"' || rest[0] == '\'') {
+			end := strings.IndexByte(rest[1:], rest[0])
+			if end == -1 {
+				return "", nil, errUnclosedAttr
+			}
+			val, rest = rest[1:end+1], rest[end+2:]
+		} else {
+			val, rest, _ = strings.Cut(rest, " ")
+		}
+		unescaped, err := unescapeHTML(val)
+		if err != nil {
+			return "", nil, err
+		}
+		attrs[key] = unescaped
+	}
+	return strings.ToLower(name), attrs, nil
+}
+
+type parseError string
+
+func (e parseError) Error() string { return string(e) }
+
+const (
+	errUnclosedAttr = parseError("unclosed attribute value")
+	errCharRef      = parseError("unsupported character reference")
+)
+
+func unescapeHTML(s string) (string, error) {
+	if !strings.Contains(s, "&") {
+		return s, nil
+	}
+	var sb strings.Builder
+	for {
+		i := strings.IndexByte(s, '&')
+		if i == -1 {
+			sb.WriteString(s)
+			return sb.String(), nil
+		}
+		sb.WriteString(s[:i])
+		end := strings.IndexByte(s[i:], ';')
+		if end == -1 {
+			return "", errCharRef
+		}
+		c, ok := htmlEntity(s[i+1 : i+end])
+		if !ok {
+			return "", errCharRef
+		}
+		sb.WriteRune(c)
+		s = s[i+end+1:]
+	}
+}
+
+func htmlEntity(name string) (rune, bool) {
+	switch name {
+	case "lt":
+		return '<', true
+	case "gt":
+		return '>', true
+	case "amp":
+		return '&', true
+	case "quot":
+		return '"', true
+	}
+	num, ok := strings.CutPrefix(name, "#")
+	if !ok {
+		return 0, false
+	}
+	base := 10
+	if hex, ok := strings.CutPrefix(num, "x"); ok {
+		num, base = hex, 16
+	} else if hex, ok := strings.CutPrefix(num, "X"); ok {
+		num, base = hex, 16
+	}
+	n, err := strconv.ParseUint(num, base, 32)
+	if err != nil {
+		return 0, false
+	}
+	return rune(n), true
+}
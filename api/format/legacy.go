@@ -0,0 +1,157 @@
+package format
+
+import (
+	"strings"
+
+	"github.com/karalef/tgot/api/tg"
+)
+
+// markdownReserved contains the characters that must be escaped in the
+// legacy Markdown parse mode.
+const markdownReserved = "_*`["
+
+// EscapeMarkdown escapes the text for the legacy Markdown parse mode.
+// It can be used only outside of the entities.
+func EscapeMarkdown(s string) string { return escape(s, markdownReserved) }
+
+// Markdown converts the text with entities to the legacy Markdown markup.
+//
+// The legacy Markdown does not support nested entities, so the entities
+// starting inside another one are omitted. The entity delimiters inside the
+// entities are written by closing and reopening the entity, so these
+// characters lose the formatting. Underline, strikethrough, spoiler, custom
+// emoji and block quotations are not supported.
+func Markdown(text string, entities []tg.MessageEntity) string {
+	var r markdownRenderer
+	walk(text, entities, false, &r)
+	return r.sb.String()
+}
+
+type markdownRenderer struct {
+	sb  strings.Builder
+	cur *tg.MessageEntity
+}
+
+func (r *markdownRenderer) supports(t tg.EntityType) bool {
+	switch t {
+	case tg.EntityBold, tg.EntityItalic, tg.EntityCode, tg.EntityCodeBlock,
+		tg.EntityTextLink, tg.EntityTextMention:
+		return true
+	}
+	return false
+}
+
+func legacyDelim(t tg.EntityType) string {
+	switch t {
+	case tg.EntityBold:
+		return "*"
+	case tg.EntityItalic:
+		return "_"
+	case tg.EntityCode:
+		return "`"
+	}
+	return ""
+}
+
+func (r *markdownRenderer) open(e *tg.MessageEntity) {
+	r.cur = e
+	switch e.Type {
+	case tg.EntityCodeBlock:
+		r.sb.WriteString("```" + e.Language + "\n")
+	case tg.EntityTextLink, tg.EntityTextMention:
+		r.sb.WriteByte('[')
+	default:
+		r.sb.WriteString(legacyDelim(e.Type))
+	}
+}
+
+func (r *markdownRenderer) close(e *tg.MessageEntity) {
+	r.cur = nil
+	switch e.Type {
+	case tg.EntityCodeBlock:
+		r.sb.WriteString("```")
+	case tg.EntityTextLink:
+		r.sb.WriteString("](" + e.URL + ")")
+	case tg.EntityTextMention:
+		r.sb.WriteString("](" + userURL(e.User) + ")")
+	default:
+		r.sb.WriteString(legacyDelim(e.Type))
+	}
+}
+
+func (r *markdownRenderer) text(s string) {
+	if r.cur == nil {
+		r.sb.WriteString(EscapeMarkdown(s))
+		return
+	}
+	if d := legacyDelim(r.cur.Type); d != "" {
+		s = strings.ReplaceAll(s, d, d+"\\"+d+d)
+	}
+	r.sb.WriteString(s)
+}
+
+// ParseMarkdown parses the legacy Markdown markup into the text with entities.
+func ParseMarkdown(s string) (string, []tg.MessageEntity, error) {
+	p := parser{mode: tg.Markdown}
+	for i := 0; i < len(s); {
+		c := s[i]
+		switch c {
+		case '\\':
+			if i+1 < len(s) && strings.IndexByte(markdownReserved, s[i+1]) != -1 {
+				p.write(s[i+1 : i+2])
+				i += 2
+				continue
+			}
+			p.write("\\")
+			i++
+		case '*', '_', '`':
+			e := tg.MessageEntity{Type: tg.EntityBold}
+			delim := s[i : i+1]
+			switch {
+			case strings.HasPrefix(s[i:], "```"):
+				e.Type, delim = tg.EntityCodeBlock, "```"
+			case c == '_':
+				e.Type = tg.EntityItalic
+			case c == '`':
+				e.Type = tg.EntityCode
+			}
+			start := i
+			i += len(delim)
+			if e.Type == tg.EntityCodeBlock {
+				if nl := strings.IndexByte(s[i:], '\n'); nl != -1 && !strings.ContainsAny(s[i:i+nl], "` ") {
+					e.Language = s[i : i+nl]
+					i += nl + 1
+				}
+			}
+			end := strings.Index(s[i:], delim)
+			if end == -1 {
+				return "", nil, p.errorf(start, "unclosed entity")
+			}
+			offset := p.pos
+			p.write(s[i : i+end])
+			p.add(e, offset)
+			i += end + len(delim)
+		case '[':
+			end := strings.Index(s[i:], "](")
+			if end == -1 {
+				return "", nil, p.errorf(i, "unclosed link")
+			}
+			urlEnd := strings.IndexByte(s[i+end:], ')')
+			if urlEnd == -1 {
+				return "", nil, p.errorf(i, "unclosed link")
+			}
+			offset := p.pos
+			p.write(s[i+1 : i+end])
+			p.add(linkEntity(s[i+end+2:i+end+urlEnd]), offset)
+			i += end + urlEnd + 1
+		default:
+			end := strings.IndexAny(s[i:], "\\*_`[")
+			if end == -1 {
+				end = len(s) - i
+			}
+			p.write(s[i : i+end])
+			i += end
+		}
+	}
+	return p.result()
+}
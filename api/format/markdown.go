@@ -0,0 +1,334 @@
+package format
+
+import (
+	"slices"
+	"strings"
+
+	"github.com/karalef/tgot/api/tg"
+)
+
+// markdownV2Reserved contains the characters that must be escaped in the
+// MarkdownV2 parse mode.
+const markdownV2Reserved = "_*[]()~`>#+-=|{}.!\\"
+
+// EscapeMarkdownV2 escapes the text for the MarkdownV2 parse mode.
+func EscapeMarkdownV2(s string) string { return escape(s, markdownV2Reserved) }
+
+// EscapeMarkdownV2Code escapes the text inside the code and pre entities.
+func EscapeMarkdownV2Code(s string) string { return escape(s, "`\\") }
+
+// EscapeMarkdownV2URL escapes the url of the inline link.
+func EscapeMarkdownV2URL(s string) string { return escape(s, ")\\") }
+
+func escape(s, chars string) string {
+	if !strings.ContainsAny(s, chars) {
+		return s
+	}
+	var sb strings.Builder
+	sb.Grow(len(s) + 8)
+	for _, c := range s {
+		if c < 128 && strings.ContainsRune(chars, c) {
+			sb.WriteByte('\\')
+		}
+		sb.WriteRune(c)
+	}
+	return sb.String()
+}
+
+// MarkdownV2 converts the text with entities to the MarkdownV2 markup.
+// The block quotations are expected to start at the beginning of a line.
+func MarkdownV2(text string, entities []tg.MessageEntity) string {
+	r := markdownV2Renderer{underscore: -1}
+	walk(text, entities, true, &r)
+	return r.sb.String()
+}
+
+type markdownV2Renderer struct {
+	sb    strings.Builder
+	code  int
+	quote int
+
+	// underscore is the length of the output after the markup ending with
+	// the underscore.
+	underscore int
+}
+
+func (r *markdownV2Renderer) supports(t tg.EntityType) bool {
+	_, ok := htmlTags[t]
+	return ok
+}
+
+func (r *markdownV2Renderer) mark(s string) {
+	// '\r' separates the italic and underline delimiters
+	if s[0] == '_' && r.sb.Len() == r.underscore {
+		r.sb.WriteByte('\r')
+	}
+	r.sb.WriteString(s)
+	if s[len(s)-1] == '_' {
+		r.underscore = r.sb.Len()
+	}
+}
+
+func (r *markdownV2Renderer) open(e *tg.MessageEntity) {
+	switch e.Type {
+	case tg.EntityBold:
+		r.mark("*")
+	case tg.EntityItalic:
+		r.mark("_")
+	case tg.EntityUnderline:
+		r.mark("__")
+	case tg.EntityStrikethrough:
+		r.mark("~")
+	case tg.EntitySpoiler:
+		r.mark("||")
+	case tg.EntityCode:
+		r.mark("`")
+		r.code++
+	case tg.EntityCodeBlock:
+		r.mark("```" + e.Language + "\n")
+		r.code++
+	case tg.EntityTextLink, tg.EntityTextMention:
+		r.mark("[")
+	case tg.EntityCustomEmoji:
+		r.mark("![")
+	case tg.EntityBlockquote:
+		r.mark(">")
+		r.quote++
+	case tg.EntityExpandableBlockQuote:
+		r.mark("**>")
+		r.quote++
+	}
+}
+
+func (r *markdownV2Renderer) close(e *tg.MessageEntity) {
+	switch e.Type {
+	case tg.EntityBold:
+		r.mark("*")
+	case tg.EntityItalic:
+		r.mark("_")
+	case tg.EntityUnderline:
+		r.mark("__")
+	case tg.EntityStrikethrough:
+		r.mark("~")
+	case tg.EntitySpoiler:
+		r.mark("||")
+	case tg.EntityCode:
+		r.mark("`")
+		r.code--
+	case tg.EntityCodeBlock:
+		r.mark("```")
+		r.code--
+	case tg.EntityTextLink:
+		r.mark("](" + EscapeMarkdownV2URL(e.URL) + ")")
+	case tg.EntityTextMention:
+		r.mark("](" + userURL(e.User) + ")")
+	case tg.EntityCustomEmoji:
+		r.mark("](" + emojiLink + EscapeMarkdownV2URL(e.CustomEmojiID) + ")")
+	case tg.EntityBlockquote:
+		r.quote--
+	case tg.EntityExpandableBlockQuote:
+		r.mark("||")
+		r.quote--
+	}
+}
+
+func (r *markdownV2Renderer) text(s string) {
+	if r.code > 0 {
+		r.sb.WriteString(EscapeMarkdownV2Code(s))
+		return
+	}
+	s = EscapeMarkdownV2(s)
+	if r.quote > 0 {
+		s = strings.ReplaceAll(s, "\n", "\n>")
+	}
+	r.sb.WriteString(s)
+}
+
+// ParseMarkdownV2 parses the MarkdownV2 markup into the text with entities.
+func ParseMarkdownV2(s string) (string, []tg.MessageEntity, error) {
+	type open struct {
+		entity tg.MessageEntity
+		offset int
+	}
+	p := parser{mode: tg.MarkdownV2}
+	var (
+		stack     []open
+		quote     *open
+		quoteEnd  = -1
+		lineStart = true
+	)
+	find := func(t tg.EntityType) int {
+		return slices.IndexFunc(stack, func(o open) bool { return o.entity.Type == t })
+	}
+	toggle := func(t tg.EntityType) {
+		if i := find(t); i != -1 {
+			p.add(stack[i].entity, stack[i].offset)
+			stack = slices.Delete(stack, i, i+1)
+			return
+		}
+		stack = append(stack, open{tg.MessageEntity{Type: t}, p.pos})
+	}
+	closeQuote := func(end int) {
+		pos := p.pos
+		p.pos = end
+		p.add(quote.entity, quote.offset)
+		p.pos = pos
+		quote = nil
+	}
+
+	for i := 0; i < len(s); {
+		if lineStart {
+			lineStart = false
+			switch {
+			case quote == nil && strings.HasPrefix(s[i:], "**>"):
+				quote = &open{tg.MessageEntity{Type: tg.EntityExpandableBlockQuote}, p.pos}
+				i += 3
+				continue
+			case s[i] == '>':
+				if quote == nil {
+					quote = &open{tg.MessageEntity{Type: tg.EntityBlockquote}, p.pos}
+				}
+				i++
+				continue
+			case quote != nil:
+				closeQuote(quoteEnd)
+			}
+		}
+
+		c := s[i]
+		switch c {
+		case '\\':
+			if i+1 >= len(s) || s[i+1] < 1 || s[i+1] > 126 {
+				return "", nil, p.errorf(i, "invalid escape")
+			}
+			p.write(s[i+1 : i+2])
+			i += 2
+		case '*':
+			toggle(tg.EntityBold)
+			i++
+		case '_':
+			if strings.HasPrefix(s[i:], "__") {
+				toggle(tg.EntityUnderline)
+				i += 2
+			} else {
+				toggle(tg.EntityItalic)
+				i++
+			}
+			if strings.HasPrefix(s[i:], "\r_") {
+				i++
+			}
+		case '~':
+			toggle(tg.EntityStrikethrough)
+			i++
+		case '|':
+			if !strings.HasPrefix(s[i:], "||") {
+				return "", nil, p.errorf(i, "character '|' is reserved")
+			}
+			i += 2
+			if quote != nil && quote.entity.Type == tg.EntityExpandableBlockQuote &&
+				find(tg.EntitySpoiler) == -1 && (i == len(s) || s[i] == '\n') {
+				closeQuote(p.pos)
+				continue
+			}
+			toggle(tg.EntitySpoiler)
+		case '`':
+			e := tg.MessageEntity{Type: tg.EntityCode}
+			i++
+			delim := "`"
+			if strings.HasPrefix(s[i-1:], "```") {
+				e.Type, delim = tg.EntityCodeBlock, "```"
+				i += 2
+				if nl := strings.IndexByte(s[i:], '\n'); nl != -1 && !strings.Contains(s[i:i+nl], "`") {
+					e.Language = s[i : i+nl]
+					i += nl + 1
+				}
+			}
+			offset := p.pos
+			for {
+				if i >= len(s) {
+					return "", nil, p.errorf(i, "unclosed code")
+				}
+				if s[i] == '\\' && i+1 < len(s) {
+					p.write(s[i+1 : i+2])
+					i += 2
+					continue
+				}
+				if strings.HasPrefix(s[i:], delim) {
+					i += len(delim)
+					break
+				}
+				end := strings.IndexAny(s[i:], "\\`")
+				if end == -1 {
+					end = len(s) - i
+				}
+				if end == 0 {
+					end = 1
+				}
+				p.write(s[i : i+end])
+				i += end
+			}
+			p.add(e, offset)
+		case '[':
+			stack = append(stack, open{tg.MessageEntity{Type: "link"}, p.pos})
+			i++
+		case '!':
+			if !strings.HasPrefix(s[i:], "![") {
+				return "", nil, p.errorf(i, "character '!' is reserved")
+			}
+			stack = append(stack, open{tg.MessageEntity{Type: "emoji"}, p.pos})
+			i += 2
+		case ']':
+			j := len(stack) - 1
+			for j >= 0 && stack[j].entity.Type != "link" && stack[j].entity.Type != "emoji" {
+				j--
+			}
+			if j == -1 || !strings.HasPrefix(s[i:], "](") {
+				return "", nil, p.errorf(i, "character ']' is reserved")
+			}
+			i += 2
+			var url strings.Builder
+			for {
+				if i >= len(s) {
+					return "", nil, p.errorf(i, "unclosed link")
+				}
+				if s[i] == '\\' && i+1 < len(s) {
+					url.WriteByte(s[i+1])
+					i += 2
+					continue
+				}
+				if s[i] == ')' {
+					i++
+					break
+				}
+				url.WriteByte(s[i])
+				i++
+			}
+			p.add(linkEntity(url.String()), stack[j].offset)
+			stack = slices.Delete(stack, j, j+1)
+		case '\n':
+			if quote != nil {
+				quoteEnd = p.pos
+			}
+			p.write("\n")
+			lineStart = true
+			i++
+		default:
+			if strings.IndexByte(markdownV2Reserved, c) != -1 {
+				return "", nil, p.errorf(i, "character '"+string(c)+"' is reserved")
+			}
+			end := strings.IndexAny(s[i+1:], markdownV2Reserved+"\n")
+			if end == -1 {
+				end = len(s) - i - 1
+			}
+			p.write(s[i : i+1+end])
+			i += 1 + end
+		}
+	}
+	if quote != nil {
+		closeQuote(p.pos)
+	}
+	if len(stack) > 0 {
+		return "", nil, p.errorf(len(s), "unclosed entity")
+	}
+	return p.result()
+}
@@ -19,6 +19,14 @@ type HTTP interface {
 	Post(ctx context.Context, url, ct string, body io.Reader) (int, io.ReadCloser, error)
 }
 
+// RangeHTTP is implemented by the HTTP clients that can request
+// the content starting from the offset.
+type RangeHTTP interface {
+	// GetRange performs a GET request with the Range header.
+	// It returns status code and response body.
+	GetRange(ctx context.Context, url string, offset int64) (int, io.ReadCloser, error)
+}
+
 // HTTPError represents HTTP error.
 type HTTPError struct {
 	Status int
@@ -56,6 +64,19 @@ func (h *stdHTTP) Get(ctx context.Context, url string) (int, io.ReadCloser, erro
 	return resp.StatusCode, resp.Body, nil
 }
 
+func (h *stdHTTP) GetRange(ctx context.Context, url string, offset int64) (int, io.ReadCloser, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return 0, nil, err
+	}
+	req.Header.Set("Range", "bytes="+strconv.FormatInt(offset, 10)+"-")
+	resp, err := (*http.Client)(h).Do(req)
+	if err != nil {
+		return 0, nil, err
+	}
+	return resp.StatusCode, resp.Body, nil
+}
+
 func (h *stdHTTP) Post(ctx context.Context, url, ct string, body io.Reader) (int, io.ReadCloser, error) {
 	req, err := http.NewRequestWithContext(ctx, http.MethodPost, url, body)
 	if err != nil {
@@ -0,0 +1,173 @@
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"go/format"
+	"slices"
+	"strconv"
+	"strings"
+)
+
+const header = "// Code generated by api/internal/gen from %s. DO NOT EDIT.\n\n"
+
+// generator emits the Go code for the spec.
+type generator struct {
+	spec *Spec
+	tg   *pkg
+
+	// generated contains the tg names of the generated types.
+	generated map[string]bool
+
+	// warnings contains the spec entries that are mapped inexactly.
+	warnings []string
+}
+
+func newGenerator(spec *Spec, tg *pkg) *generator {
+	g := &generator{
+		spec:      spec,
+		tg:        tg,
+		generated: make(map[string]bool),
+	}
+	for _, name := range sortedKeys(spec.Types) {
+		if t := spec.Types[name]; g.missing(t) {
+			g.generated[tgName(name)] = true
+		}
+	}
+	return g
+}
+
+// missing reports whether the type must be generated.
+func (g *generator) missing(t *Type) bool {
+	return !t.IsUnion() && !g.tg.has(tgName(t.Name))
+}
+
+// known reports whether the tg type exists after the generation.
+func (g *generator) known(name string) bool {
+	return g.tg.has(name) || g.generated[name]
+}
+
+func (g *generator) warn(format string, args ...any) {
+	g.warnings = append(g.warnings, fmt.Sprintf(format, args...))
+}
+
+func (g *generator) mapper(qual string) *mapper {
+	return &mapper{spec: g.spec, tg: g.tg, qual: qual, known: g.known}
+}
+
+// types generates the tg types that are not declared by hand.
+func (g *generator) types() ([]byte, error) {
+	m := g.mapper("")
+	var b bytes.Buffer
+	fmt.Fprintf(&b, header, g.spec.Version)
+	b.WriteString("package tg\n")
+	for _, name := range sortedKeys(g.spec.Types) {
+		t := g.spec.Types[name]
+		if !g.missing(t) {
+			continue
+		}
+		tn := tgName(name)
+		fmt.Fprintf(&b, "\n// %s\n", comment(tn, t.Description, "represents the "+name+" object."))
+		fmt.Fprintf(&b, "//\n// %s\n", t.Href)
+		if len(t.Fields) == 0 {
+			fmt.Fprintf(&b, "type %s struct{}\n", tn)
+			continue
+		}
+		fmt.Fprintf(&b, "type %s struct {\n", tn)
+		for _, f := range t.Fields {
+			typ := g.check(m, name+"."+f.Name, m.fieldType(f))
+			tag := f.Name
+			if !f.Required {
+				tag += ",omitempty"
+			}
+			fmt.Fprintf(&b, "%s %s `json:%s`\n", goName(f.Name), typ, strconv.Quote(tag))
+		}
+		b.WriteString("}\n")
+	}
+	return format.Source(b.Bytes())
+}
+
+// methods generates the raw method wrappers.
+func (g *generator) methods() ([]byte, error) {
+	m := g.mapper("tg.")
+	var b bytes.Buffer
+	fmt.Fprintf(&b, header, g.spec.Version)
+	b.WriteString("package methods\n\n")
+	b.WriteString("import (\n\"context\"\n\n\"github.com/karalef/tgot/api\"\n\"github.com/karalef/tgot/api/tg\"\n)\n")
+	for _, name := range sortedKeys(g.spec.Methods) {
+		meth := g.spec.Methods[name]
+		typ := goName(name)
+		fmt.Fprintf(&b, "\n// %s\n", comment(typ, meth.Description, "contains the parameters of the "+name+" method."))
+		fmt.Fprintf(&b, "//\n// %s\n", meth.Href)
+		if len(meth.Fields) == 0 {
+			fmt.Fprintf(&b, "type %s struct{}\n", typ)
+		} else {
+			fmt.Fprintf(&b, "type %s struct {\n", typ)
+			for _, f := range meth.Fields {
+				ft := g.check(m, name+"."+f.Name, m.fieldType(f))
+				tag := f.Name
+				if f.Required && scalar(ft) && ft != "string" {
+					tag += ",force"
+				}
+				fmt.Fprintf(&b, "%s %s `tg:%s`\n", goName(f.Name), ft, strconv.Quote(tag))
+			}
+			b.WriteString("}\n")
+		}
+
+		fmt.Fprintf(&b, "\n// Method returns the method name.\n")
+		fmt.Fprintf(&b, "func (%s) Method() string { return %q }\n", typ, name)
+		g.do(&b, m, meth, typ)
+	}
+	return format.Source(b.Bytes())
+}
+
+func (g *generator) do(b *bytes.Buffer, m *mapper, meth *Method, typ string) {
+	const doc = "\n// Do performs the request.\n"
+	ret := meth.Returns
+	switch {
+	case slices.Equal(ret, []string{"True"}):
+		fmt.Fprintf(b, doc+"func (p %s) Do(ctx context.Context, a *api.API) error {\n", typ)
+		b.WriteString("_, err := request[api.Empty](ctx, a, p)\nreturn err\n}\n")
+		return
+	case len(ret) == 2 && ret[1] == "True" && hasField(meth, "inline_message_id"):
+		// the inline messages are not returned
+		rt := g.check(m, meth.Name, m.goType("", ret[:1], false))
+		fmt.Fprintf(b, doc+"// If the message is inline, the result is nil.\n")
+		fmt.Fprintf(b, "func (p %s) Do(ctx context.Context, a *api.API) (%s, error) {\n", typ, rt)
+		b.WriteString("if p.InlineMessageID != \"\" {\n_, err := request[api.Empty](ctx, a, p)\nreturn nil, err\n}\n")
+		fmt.Fprintf(b, "return request[%s](ctx, a, p)\n}\n", rt)
+		return
+	}
+	rt := g.check(m, meth.Name, m.goType("", ret, false))
+	fmt.Fprintf(b, doc+"func (p %s) Do(ctx context.Context, a *api.API) (%s, error) {\n", typ, rt)
+	fmt.Fprintf(b, "return request[%s](ctx, a, p)\n}\n", rt)
+}
+
+// check records the warning if the type is mapped inexactly.
+func (g *generator) check(m *mapper, where, typ string) string {
+	if strings.Contains(typ, "any") {
+		g.warn("%s: unmapped type, using %s", where, typ)
+	}
+	return typ
+}
+
+func hasField(meth *Method, name string) bool {
+	for _, f := range meth.Fields {
+		if f.Name == name {
+			return true
+		}
+	}
+	return false
+}
+
+// comment returns the doc comment text starting with the name.
+func comment(name string, desc []string, fallback string) string {
+	d := doc(desc)
+	if rest, ok := strings.CutPrefix(d, "Use this method to "); ok {
+		return name + " is used to " + rest
+	}
+	if rest, ok := strings.CutPrefix(d, "This object "); ok {
+		return name + " " + rest
+	}
+	return name + " " + fallback
+}
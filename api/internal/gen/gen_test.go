@@ -0,0 +1,179 @@
+package main
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+const testSpec = `{
+ "version": "Bot API 0.1",
+ "methods": {
+  "sendThing": {
+   "name": "sendThing",
+   "returns": ["Message"],
+   "fields": [
+    {"name": "chat_id", "types": ["Integer", "String"], "required": true},
+    {"name": "count", "types": ["Integer"], "required": true},
+    {"name": "thing", "types": ["InputFile", "String"], "required": true},
+    {"name": "entities", "types": ["Array of MessageEntity"]},
+    {"name": "shape", "types": ["ShapeCircle", "ShapeSquare"]}
+   ]
+  },
+  "editThing": {
+   "name": "editThing",
+   "returns": ["Message", "True"],
+   "fields": [
+    {"name": "inline_message_id", "types": ["String"]},
+    {"name": "parse_mode", "types": ["String"]}
+   ]
+  },
+  "deleteThing": {"name": "deleteThing", "returns": ["True"]}
+ },
+ "types": {
+  "Message": {"name": "Message", "fields": [
+   {"name": "message_id", "types": ["Integer"], "required": true},
+   {"name": "date", "types": ["Integer"], "required": true},
+   {"name": "text", "types": ["String"]}
+  ]},
+  "MessageEntity": {"name": "MessageEntity", "fields": [
+   {"name": "offset", "types": ["Integer"], "required": true}
+  ]},
+  "Shape": {"name": "Shape", "subtypes": ["ShapeCircle", "ShapeSquare"]},
+  "ShapeCircle": {"name": "ShapeCircle", "subtype_of": ["Shape"], "fields": [
+   {"name": "type", "types": ["String"], "required": true},
+   {"name": "radius", "types": ["Float"], "required": true}
+  ]},
+  "ShapeSquare": {"name": "ShapeSquare", "subtype_of": ["Shape"], "fields": [
+   {"name": "type", "types": ["String"], "required": true}
+  ]},
+  "LoginUrl": {"name": "LoginUrl", "fields": [
+   {"name": "url", "types": ["String"], "required": true},
+   {"name": "forward_text", "types": ["String"]}
+  ]}
+ }
+}`
+
+const testTg = `package tg
+
+type ID int64
+type ParseMode string
+type ChatID Identifier
+type Identifier interface{ identifier() }
+type Inputtable interface{ FileData() }
+
+type Message struct {
+	ID   ID     ` + "`json:\"message_id\"`" + `
+	Date int64  ` + "`json:\"date\"`" + `
+}
+
+type MessageEntity struct {
+	Offset int
+}
+
+type Shape struct{}
+type ShapeCircle struct {
+	Radius float64 ` + "`json:\"radius\"`" + `
+}
+type ShapeSquare struct{}
+`
+
+func setup(t *testing.T) (*Spec, *generator) {
+	dir := t.TempDir()
+	spec := filepath.Join(dir, "api.json")
+	if err := os.WriteFile(spec, []byte(testSpec), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	s, err := loadSpec(spec)
+	if err != nil {
+		t.Fatal(err)
+	}
+	tgDir := filepath.Join(dir, "tg")
+	if err = os.Mkdir(tgDir, 0o755); err != nil {
+		t.Fatal(err)
+	}
+	err = os.WriteFile(filepath.Join(tgDir, "tg.go"), []byte(testTg), 0o644)
+	if err != nil {
+		t.Fatal(err)
+	}
+	tg, err := parsePkg(tgDir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return s, newGenerator(s, tg)
+}
+
+func TestMethods(t *testing.T) {
+	_, g := setup(t)
+	src, err := g.methods()
+	if err != nil {
+		t.Fatal(err)
+	}
+	code := string(src)
+	for _, s := range []string{
+		"ChatID   tg.ChatID",
+		"`tg:\"count,force\"`",
+		"Thing    tg.Inputtable",
+		"Entities []tg.MessageEntity",
+		"Shape    tg.Shape",
+		"func (p DeleteThing) Do(ctx context.Context, a *api.API) error {",
+		"if p.InlineMessageID != \"\" {",
+		"ParseMode       tg.ParseMode",
+		"return request[*tg.Message](ctx, a, p)",
+	} {
+		if !strings.Contains(code, s) {
+			t.Errorf("generated code does not contain %q:\n%s", s, code)
+		}
+	}
+	if len(g.warnings) > 0 {
+		t.Errorf("unexpected warnings: %v", g.warnings)
+	}
+}
+
+func TestTypes(t *testing.T) {
+	_, g := setup(t)
+	src, err := g.types()
+	if err != nil {
+		t.Fatal(err)
+	}
+	code := string(src)
+	if !strings.Contains(code, "type LoginURL struct") ||
+		!strings.Contains(code, "`json:\"forward_text,omitempty\"`") {
+		t.Errorf("LoginURL is not generated:\n%s", code)
+	}
+	if strings.Contains(code, "type Message ") || strings.Contains(code, "type Shape ") {
+		t.Errorf("declared types are generated:\n%s", code)
+	}
+}
+
+func TestCoverage(t *testing.T) {
+	_, g := setup(t)
+	r := coverage(g, map[string]bool{"sendThing": true})
+	if len(r.methods) != 1 || len(r.missingMethods) != 2 {
+		t.Errorf("unexpected methods coverage: %v, %v", r.methods, r.missingMethods)
+	}
+	if len(r.generatedTypes) != 1 || r.generatedTypes[0] != "LoginUrl" {
+		t.Errorf("unexpected generated types: %v", r.generatedTypes)
+	}
+	// the untagged field is named in snake case, the discriminator is ignored
+	missing := r.missingFields
+	if len(missing) != 1 || len(missing["Message"]) != 1 || missing["Message"][0] != "text" {
+		t.Errorf("unexpected missing fields: %v", missing)
+	}
+}
+
+func TestGoName(t *testing.T) {
+	for s, expected := range map[string]string{
+		"chat_id":            "ChatID",
+		"message_ids":        "MessageIDs",
+		"thumbnail_url":      "ThumbnailURL",
+		"sendMessage":        "SendMessage",
+		"gif_file_id":        "GIFFileID",
+		"show_caption_above": "ShowCaptionAbove",
+	} {
+		if n := goName(s); n != expected {
+			t.Errorf("goName(%q) = %q, expected %q", s, n, expected)
+		}
+	}
+}
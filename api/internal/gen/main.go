@@ -0,0 +1,91 @@
+// Command gen generates the raw method wrappers from the machine-readable
+// Bot API specification and prints the coverage report.
+//
+// The tg types that are not declared by hand are generated into
+// api/tg/types_gen.go, which is removed when there are none. The fields
+// missing from the hand-written types are only reported and must be added
+// by hand.
+//
+// Usage:
+//
+//	go run ./api/internal/gen -spec api/internal/gen/api.json -root .
+package main
+
+import (
+	"errors"
+	"flag"
+	"fmt"
+	"os"
+	"path/filepath"
+)
+
+const (
+	typesFile   = "types_gen.go"
+	methodsFile = "methods_gen.go"
+)
+
+func main() {
+	specPath := flag.String("spec", "api.json", "path to the spec")
+	root := flag.String("root", ".", "path to the module root")
+	reportOnly := flag.Bool("report", false, "only print the coverage report")
+	flag.Parse()
+
+	if err := run(*specPath, *root, *reportOnly); err != nil {
+		fmt.Fprintln(os.Stderr, "gen:", err)
+		os.Exit(1)
+	}
+}
+
+func run(specPath, root string, reportOnly bool) error {
+	spec, err := loadSpec(specPath)
+	if err != nil {
+		return err
+	}
+	tgDir := filepath.Join(root, "api", "tg")
+	tg, err := parsePkg(tgDir, typesFile)
+	if err != nil {
+		return err
+	}
+	g := newGenerator(spec, tg)
+
+	types, err := g.types()
+	if err != nil {
+		return fmt.Errorf("types: %w", err)
+	}
+	methods, err := g.methods()
+	if err != nil {
+		return fmt.Errorf("methods: %w", err)
+	}
+	if !reportOnly {
+		if len(g.generated) > 0 {
+			err = write(filepath.Join(tgDir, typesFile), types)
+		} else {
+			err = os.Remove(filepath.Join(tgDir, typesFile))
+			if errors.Is(err, os.ErrNotExist) {
+				err = nil
+			}
+		}
+		if err != nil {
+			return err
+		}
+		err = write(filepath.Join(root, "api", "methods", methodsFile), methods)
+		if err != nil {
+			return err
+		}
+	}
+
+	lits, err := stringLiterals(root, "api/methods", "api/internal/gen")
+	if err != nil {
+		return err
+	}
+	coverage(g, lits).write(os.Stdout)
+	return nil
+}
+
+// write writes the generated file creating the directory if needed.
+func write(path string, src []byte) error {
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		return err
+	}
+	return os.WriteFile(path, src, 0o644)
+}
@@ -0,0 +1,198 @@
+package main
+
+import (
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"os"
+	"path/filepath"
+	"reflect"
+	"slices"
+	"strconv"
+	"strings"
+	"unicode"
+)
+
+// pkg contains the type declarations of the Go package.
+type pkg struct {
+	types map[string]*ast.TypeSpec
+
+	// marshalers contains the types that implement json.Marshaler.
+	marshalers map[string]bool
+}
+
+// parsePkg parses the type declarations of the package in dir.
+// The test files and the files listed in skip are ignored.
+func parsePkg(dir string, skip ...string) (*pkg, error) {
+	files, err := filepath.Glob(filepath.Join(dir, "*.go"))
+	if err != nil {
+		return nil, err
+	}
+	p := &pkg{
+		types:      make(map[string]*ast.TypeSpec),
+		marshalers: make(map[string]bool),
+	}
+	fset := token.NewFileSet()
+	for _, name := range files {
+		base := filepath.Base(name)
+		if strings.HasSuffix(base, "_test.go") || slices.Contains(skip, base) {
+			continue
+		}
+		f, err := parser.ParseFile(fset, name, nil, parser.SkipObjectResolution)
+		if err != nil {
+			return nil, err
+		}
+		for _, decl := range f.Decls {
+			if fd, ok := decl.(*ast.FuncDecl); ok {
+				if fd.Recv != nil && fd.Name.Name == "MarshalJSON" {
+					p.marshalers[recvName(fd.Recv.List[0].Type)] = true
+				}
+				continue
+			}
+			gd, ok := decl.(*ast.GenDecl)
+			if !ok || gd.Tok != token.TYPE {
+				continue
+			}
+			for _, s := range gd.Specs {
+				ts := s.(*ast.TypeSpec)
+				p.types[ts.Name.Name] = ts
+			}
+		}
+	}
+	return p, nil
+}
+
+func recvName(e ast.Expr) string {
+	switch t := e.(type) {
+	case *ast.StarExpr:
+		return recvName(t.X)
+	case *ast.IndexExpr:
+		return recvName(t.X)
+	case *ast.IndexListExpr:
+		return recvName(t.X)
+	case *ast.Ident:
+		return t.Name
+	}
+	return ""
+}
+
+func (p *pkg) has(name string) bool {
+	_, ok := p.types[name]
+	return ok
+}
+
+func (p *pkg) isInterface(name string) bool {
+	ts, ok := p.types[name]
+	if !ok {
+		return false
+	}
+	if _, ok := ts.Type.(*ast.InterfaceType); ok {
+		return true
+	}
+	// type ChatID Identifier
+	if id, ok := ts.Type.(*ast.Ident); ok && ts.Assign == 0 {
+		return p.isInterface(id.Name)
+	}
+	return false
+}
+
+// jsonFields returns the json names of the struct fields including the
+// embedded and nested anonymous structs. The untagged fields are named in
+// snake case.
+func (p *pkg) jsonFields(name string) map[string]bool {
+	fields := make(map[string]bool)
+	p.collect(name, fields, make(map[string]bool))
+	return fields
+}
+
+func (p *pkg) collect(name string, fields, seen map[string]bool) {
+	ts, ok := p.types[name]
+	if !ok || seen[name] {
+		return
+	}
+	seen[name] = true
+	if st, ok := ts.Type.(*ast.StructType); ok {
+		p.collectStruct(st, fields, seen)
+	}
+}
+
+func (p *pkg) collectStruct(st *ast.StructType, fields, seen map[string]bool) {
+	for _, f := range st.Fields.List {
+		var tag string
+		if f.Tag != nil {
+			t, _ := strconv.Unquote(f.Tag.Value)
+			tag, _, _ = strings.Cut(reflect.StructTag(t).Get("json"), ",")
+		}
+		typ := f.Type
+		if star, ok := typ.(*ast.StarExpr); ok {
+			typ = star.X
+		}
+		switch t := typ.(type) {
+		case *ast.StructType:
+			p.collectStruct(t, fields, seen)
+		case *ast.Ident:
+			if len(f.Names) == 0 {
+				p.collect(t.Name, fields, seen)
+			}
+		}
+		switch {
+		case tag == "-":
+		case tag != "":
+			fields[tag] = true
+		default:
+			for _, n := range f.Names {
+				fields[snake(n.Name)] = true
+			}
+		}
+	}
+}
+
+func snake(s string) string {
+	var sb strings.Builder
+	for i, r := range s {
+		if unicode.IsUpper(r) {
+			if i > 0 && !unicode.IsUpper(rune(s[i-1])) {
+				sb.WriteByte('_')
+			}
+			r = unicode.ToLower(r)
+		}
+		sb.WriteRune(r)
+	}
+	return sb.String()
+}
+
+// stringLiterals returns all the string literals in the Go files under root.
+// The test files and the skipped directories are ignored.
+func stringLiterals(root string, skip ...string) (map[string]bool, error) {
+	lits := make(map[string]bool)
+	fset := token.NewFileSet()
+	err := filepath.WalkDir(root, func(path string, d os.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if d.IsDir() {
+			rel, _ := filepath.Rel(root, path)
+			if strings.HasPrefix(d.Name(), ".") && path != root || slices.Contains(skip, filepath.ToSlash(rel)) {
+				return filepath.SkipDir
+			}
+			return nil
+		}
+		if !strings.HasSuffix(path, ".go") || strings.HasSuffix(path, "_test.go") {
+			return nil
+		}
+		f, err := parser.ParseFile(fset, path, nil, parser.SkipObjectResolution)
+		if err != nil {
+			return err
+		}
+		ast.Inspect(f, func(n ast.Node) bool {
+			if lit, ok := n.(*ast.BasicLit); ok && lit.Kind == token.STRING {
+				if s, err := strconv.Unquote(lit.Value); err == nil {
+					lits[s] = true
+				}
+			}
+			return true
+		})
+		return nil
+	})
+	return lits, err
+}
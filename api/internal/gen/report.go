@@ -0,0 +1,112 @@
+package main
+
+import (
+	"fmt"
+	"io"
+	"slices"
+)
+
+// report describes the coverage of the spec by the hand-written code.
+type report struct {
+	version string
+
+	methods, missingMethods []string
+	types, generatedTypes   []string
+
+	// missingFields maps the hand-written type to the fields
+	// that are in the spec but not in the type.
+	missingFields map[string][]string
+
+	warnings []string
+}
+
+// coverage builds the coverage report.
+// The lits are the string literals of the hand-written code.
+func coverage(g *generator, lits map[string]bool) *report {
+	r := &report{
+		version:       g.spec.Version,
+		missingFields: make(map[string][]string),
+		warnings:      g.warnings,
+	}
+	for _, name := range sortedKeys(g.spec.Methods) {
+		if lits[name] {
+			r.methods = append(r.methods, name)
+		} else {
+			r.missingMethods = append(r.missingMethods, name)
+		}
+	}
+	for _, name := range sortedKeys(g.spec.Types) {
+		t := g.spec.Types[name]
+		if t.IsUnion() {
+			continue
+		}
+		goName := tgName(name)
+		if g.generated[goName] {
+			r.generatedTypes = append(r.generatedTypes, name)
+			continue
+		}
+		r.types = append(r.types, name)
+		if missing := g.missingFields(t); len(missing) > 0 {
+			r.missingFields[name] = missing
+		}
+	}
+	return r
+}
+
+// missingFields returns the spec fields that are not declared by the
+// hand-written type or its union.
+// The types that are marshaled by hand are not checked.
+func (g *generator) missingFields(t *Type) []string {
+	name := tgName(t.Name)
+	if g.tg.marshalers[name] {
+		return nil
+	}
+	fields := g.tg.jsonFields(name)
+	for _, parent := range t.SubtypeOf {
+		// the common fields can be stored by the union wrapper
+		// that is not renamed (InlineQueryResult).
+		for _, p := range []string{parent, tgName(parent)} {
+			for f := range g.tg.jsonFields(p) {
+				fields[f] = true
+			}
+		}
+	}
+	var missing []string
+	for _, f := range t.Fields {
+		if fields[f.Name] {
+			continue
+		}
+		// the discriminators are stored by the union
+		if len(t.SubtypeOf) > 0 && slices.Contains(discriminators, f.Name) {
+			continue
+		}
+		missing = append(missing, f.Name)
+	}
+	return missing
+}
+
+func (r *report) write(w io.Writer) {
+	total := len(r.methods) + len(r.missingMethods)
+	fmt.Fprintf(w, "%s\n\n", r.version)
+	fmt.Fprintf(w, "methods: %d/%d hand-written, all generated\n", len(r.methods), total)
+	for _, m := range r.missingMethods {
+		fmt.Fprintf(w, "\t%s (generated only)\n", m)
+	}
+	total = len(r.types) + len(r.generatedTypes)
+	fmt.Fprintf(w, "\ntypes: %d/%d hand-written, %d generated\n", len(r.types), total, len(r.generatedTypes))
+	for _, t := range r.generatedTypes {
+		fmt.Fprintf(w, "\t%s (generated)\n", t)
+	}
+	if len(r.missingFields) > 0 {
+		fmt.Fprintf(w, "\nmissing fields (not generated):\n")
+		for _, t := range sortedKeys(r.missingFields) {
+			fmt.Fprintf(w, "\t%s: %v\n", t, r.missingFields[t])
+		}
+	}
+	if len(r.warnings) > 0 {
+		fmt.Fprintf(w, "\nwarnings:\n")
+		for _, warn := range r.warnings {
+			fmt.Fprintf(w, "\t%s\n", warn)
+		}
+	}
+}
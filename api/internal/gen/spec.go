@@ -0,0 +1,105 @@
+package main
+
+import (
+	"encoding/json"
+	"os"
+	"slices"
+	"strings"
+)
+
+// Spec is the machine-readable Bot API specification in the format of
+// https://github.com/PaulSonOfLars/telegram-bot-api-spec.
+type Spec struct {
+	Version     string             `json:"version"`
+	ReleaseDate string             `json:"release_date"`
+	Changelog   string             `json:"changelog"`
+	Methods     map[string]*Method `json:"methods"`
+	Types       map[string]*Type   `json:"types"`
+}
+
+// Method describes the Bot API method.
+type Method struct {
+	Name        string   `json:"name"`
+	Href        string   `json:"href"`
+	Description []string `json:"description"`
+	Returns     []string `json:"returns"`
+	Fields      []Field  `json:"fields"`
+}
+
+// Type describes the Bot API object.
+type Type struct {
+	Name        string   `json:"name"`
+	Href        string   `json:"href"`
+	Description []string `json:"description"`
+	Fields      []Field  `json:"fields"`
+	Subtypes    []string `json:"subtypes"`
+	SubtypeOf   []string `json:"subtype_of"`
+}
+
+// IsUnion reports whether the type is one of the subtypes.
+func (t *Type) IsUnion() bool { return len(t.Subtypes) > 0 }
+
+// Field describes the object field or the method parameter.
+type Field struct {
+	Name        string   `json:"name"`
+	Types       []string `json:"types"`
+	Required    bool     `json:"required"`
+	Description string   `json:"description"`
+}
+
+func loadSpec(path string) (*Spec, error) {
+	b, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	var s Spec
+	if err = json.Unmarshal(b, &s); err != nil {
+		return nil, err
+	}
+	return &s, nil
+}
+
+func sortedKeys[V any](m map[string]V) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	slices.Sort(keys)
+	return keys
+}
+
+// goName converts the snake_case or camelCase name to the exported Go name.
+func goName(s string) string {
+	var sb strings.Builder
+	for _, part := range strings.Split(s, "_") {
+		if part == "" {
+			continue
+		}
+		if up, ok := initialisms[part]; ok {
+			sb.WriteString(up)
+			continue
+		}
+		sb.WriteString(strings.ToUpper(part[:1]) + part[1:])
+	}
+	return sb.String()
+}
+
+var initialisms = map[string]string{
+	"id":  "ID",
+	"ids": "IDs",
+	"url": "URL",
+	"ip":  "IP",
+	"gif": "GIF",
+}
+
+// doc returns the first sentence of the description.
+func doc(desc []string) string {
+	if len(desc) == 0 {
+		return ""
+	}
+	s := desc[0]
+	if i := strings.Index(s, ". "); i != -1 {
+		s = s[:i+1]
+	}
+	return s
+}
@@ -0,0 +1,157 @@
+package main
+
+import (
+	"slices"
+	"strings"
+)
+
+// renames maps the spec type names to the tg package type names.
+var renames = map[string]string{
+	"MessageId":                       "MessageID",
+	"LoginUrl":                        "LoginURL",
+	"BotCommand":                      "Command",
+	"BotCommandScope":                 "CommandScope",
+	"ChosenInlineResult":              "InlineChosen",
+	"MessageAutoDeleteTimerChanged":   "AutoDeleteTimer",
+	"KeyboardButtonPollType":          "ButtonPollType",
+	"ProximityAlertTriggered":         "ProximityAlert",
+	"VideoChatParticipantsInvited":    "VideoChatInvited",
+	"InlineQueryResult":               "InlineQueryResulter",
+	"InlineQueryResultGif":            "InlineQueryResultGIF",
+	"InlineQueryResultMpeg4Gif":       "InlineQueryResultMPEG4GIF",
+	"InlineQueryResultCachedGif":      "InlineQueryResultCachedGIF",
+	"InlineQueryResultCachedMpeg4Gif": "InlineQueryResultCachedMPEG4GIF",
+	"InputFile":                       "Inputtable",
+
+	// the scopes are represented by the single type
+	"BotCommandScopeDefault":               "CommandScope",
+	"BotCommandScopeAllPrivateChats":       "CommandScope",
+	"BotCommandScopeAllGroupChats":         "CommandScope",
+	"BotCommandScopeAllChatAdministrators": "CommandScope",
+	"BotCommandScopeChat":                  "CommandScope",
+	"BotCommandScopeChatAdministrators":    "CommandScope",
+	"BotCommandScopeChatMember":            "CommandScope",
+}
+
+// tgName returns the tg package name of the spec type.
+func tgName(name string) string {
+	if n, ok := renames[name]; ok {
+		return n
+	}
+	return name
+}
+
+// discriminators are the union subtype fields that are stored by the union.
+var discriminators = []string{"type", "status", "source"}
+
+// mapper maps the spec types to the Go types.
+type mapper struct {
+	spec *Spec
+	tg   *pkg
+
+	// qual is the tg package qualifier.
+	qual string
+
+	// known reports whether the tg type exists.
+	known func(string) bool
+}
+
+// fieldType returns the Go type of the field or parameter.
+func (m *mapper) fieldType(f Field) string {
+	return m.goType(f.Name, f.Types, f.Required)
+}
+
+func (m *mapper) goType(name string, types []string, required bool) string {
+	if elems, ok := arrayOf(types); ok {
+		return "[]" + m.goType(strings.TrimSuffix(name, "s"), elems, true)
+	}
+	if len(types) > 1 {
+		return m.alternatives(name, types)
+	}
+	switch t := types[0]; t {
+	case "Integer":
+		return m.intType(name)
+	case "Float":
+		return "float64"
+	case "String":
+		if name == "parse_mode" || strings.HasSuffix(name, "_parse_mode") {
+			return m.qual + "ParseMode"
+		}
+		return "string"
+	case "Boolean", "True":
+		return "bool"
+	default:
+		return m.object(t, required)
+	}
+}
+
+// arrayOf returns the element types if all the types are arrays.
+func arrayOf(types []string) ([]string, bool) {
+	elems := make([]string, len(types))
+	for i, t := range types {
+		e, ok := strings.CutPrefix(t, "Array of ")
+		if !ok {
+			return nil, false
+		}
+		elems[i] = e
+	}
+	return elems, true
+}
+
+func (m *mapper) intType(name string) string {
+	switch {
+	case name == "id" || strings.HasSuffix(name, "_id"):
+		return m.qual + "ID"
+	case name == "date" || strings.HasSuffix(name, "_date"):
+		return m.qual + "Date"
+	case name == "duration" || strings.HasSuffix(name, "_duration") ||
+		strings.HasSuffix(name, "_period"):
+		return m.qual + "Duration"
+	}
+	return "int"
+}
+
+func (m *mapper) alternatives(name string, types []string) string {
+	switch {
+	case slices.Equal(types, []string{"Integer", "String"}):
+		return m.qual + "ChatID"
+	case slices.Contains(types, "InputFile"):
+		return m.qual + "Inputtable"
+	case name == "reply_markup":
+		return m.qual + "ReplyMarkup"
+	}
+	// the subtypes of the same union
+	var union string
+	for _, t := range types {
+		st, ok := m.spec.Types[t]
+		if !ok || len(st.SubtypeOf) == 0 {
+			return "any"
+		}
+		if union == "" {
+			union = st.SubtypeOf[0]
+		} else if !slices.Contains(st.SubtypeOf, union) {
+			return "any"
+		}
+	}
+	return m.object(union, true)
+}
+
+func (m *mapper) object(name string, required bool) string {
+	goName := tgName(name)
+	if !m.known(goName) {
+		return "any"
+	}
+	if m.tg.isInterface(goName) || required {
+		return m.qual + goName
+	}
+	return "*" + m.qual + goName
+}
+
+// scalar reports whether the Go type is sent as the plain parameter.
+func scalar(typ string) bool {
+	switch strings.TrimPrefix(typ, "tg.") {
+	case "int", "float64", "bool", "string", "ID", "Date", "Duration":
+		return true
+	}
+	return false
+}
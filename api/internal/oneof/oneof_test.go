@@ -42,7 +42,7 @@ func TestOneof(t *testing.T) {
 		t.Fatalf("expected %s, got %s", one, d.Type())
 	}
 	if d.Value.(oneT).A != 1 {
-		t.Fatalf("expected str, got %s", d.Value.(oneT).A)
+		t.Fatalf("expected 1, got %d", d.Value.(oneT).A)
 	}
 }
 
@@ -51,7 +51,7 @@ func checkType(t *testing.T, j []byte, expected oneofTestType) {
 	if err := json.Unmarshal(j, &v); err != nil {
 		t.Fatal(err)
 	}
-	typ, ok := v["source"]
+	typ, ok := v["type"]
 	if !ok {
 		t.Fatal("no type field", v)
 	}
@@ -0,0 +1,26 @@
+// Package methods contains the raw Bot API method wrappers generated from
+// the vendored specification.
+//
+// The wrappers map the method parameters one-to-one and perform no checks,
+// so they can be used for the methods that are not yet covered by the
+// high-level contexts.
+package methods
+
+import (
+	"context"
+
+	"github.com/karalef/tgot/api"
+)
+
+//go:generate go run ../internal/gen -spec ../internal/gen/api.json -root ../..
+
+// Method represents the Bot API method parameters.
+type Method interface {
+	Method() string
+}
+
+func request[T any](ctx context.Context, a *api.API, m Method) (T, error) {
+	d := api.NewDataFrom(m)
+	defer d.Put()
+	return api.Request[T](ctx, a, m.Method(), d)
+}
@@ -0,0 +1,3032 @@
+// Code generated by api/internal/gen from Bot API 9.1. DO NOT EDIT.
+
+package methods
+
+import (
+	"context"
+
+	"github.com/karalef/tgot/api"
+	"github.com/karalef/tgot/api/tg"
+)
+
+// AddStickerToSet contains the parameters of the addStickerToSet method.
+//
+// https://core.telegram.org/bots/api#addstickertoset
+type AddStickerToSet struct {
+	UserID  tg.ID           `tg:"user_id,force"`
+	Name    string          `tg:"name"`
+	Sticker tg.InputSticker `tg:"sticker"`
+}
+
+// Method returns the method name.
+func (AddStickerToSet) Method() string { return "addStickerToSet" }
+
+// Do performs the request.
+func (p AddStickerToSet) Do(ctx context.Context, a *api.API) error {
+	_, err := request[api.Empty](ctx, a, p)
+	return err
+}
+
+// AnswerCallbackQuery contains the parameters of the answerCallbackQuery method.
+//
+// https://core.telegram.org/bots/api#answercallbackquery
+type AnswerCallbackQuery struct {
+	CallbackQueryID string `tg:"callback_query_id"`
+	Text            string `tg:"text"`
+	ShowAlert       bool   `tg:"show_alert"`
+	URL             string `tg:"url"`
+	CacheTime       int    `tg:"cache_time"`
+}
+
+// Method returns the method name.
+func (AnswerCallbackQuery) Method() string { return "answerCallbackQuery" }
+
+// Do performs the request.
+func (p AnswerCallbackQuery) Do(ctx context.Context, a *api.API) error {
+	_, err := request[api.Empty](ctx, a, p)
+	return err
+}
+
+// AnswerInlineQuery contains the parameters of the answerInlineQuery method.
+//
+// https://core.telegram.org/bots/api#answerinlinequery
+type AnswerInlineQuery struct {
+	InlineQueryID string                       `tg:"inline_query_id"`
+	Results       []tg.InlineQueryResulter     `tg:"results"`
+	CacheTime     int                          `tg:"cache_time"`
+	IsPersonal    bool                         `tg:"is_personal"`
+	NextOffset    string                       `tg:"next_offset"`
+	Button        *tg.InlineQueryResultsButton `tg:"button"`
+}
+
+// Method returns the method name.
+func (AnswerInlineQuery) Method() string { return "answerInlineQuery" }
+
+// Do performs the request.
+func (p AnswerInlineQuery) Do(ctx context.Context, a *api.API) error {
+	_, err := request[api.Empty](ctx, a, p)
+	return err
+}
+
+// AnswerPreCheckoutQuery contains the parameters of the answerPreCheckoutQuery method.
+//
+// https://core.telegram.org/bots/api#answerprecheckoutquery
+type AnswerPreCheckoutQuery struct {
+	PreCheckoutQueryID string `tg:"pre_checkout_query_id"`
+	Ok                 bool   `tg:"ok,force"`
+	ErrorMessage       string `tg:"error_message"`
+}
+
+// Method returns the method name.
+func (AnswerPreCheckoutQuery) Method() string { return "answerPreCheckoutQuery" }
+
+// Do performs the request.
+func (p AnswerPreCheckoutQuery) Do(ctx context.Context, a *api.API) error {
+	_, err := request[api.Empty](ctx, a, p)
+	return err
+}
+
+// AnswerShippingQuery contains the parameters of the answerShippingQuery method.
+//
+// https://core.telegram.org/bots/api#answershippingquery
+type AnswerShippingQuery struct {
+	ShippingQueryID string              `tg:"shipping_query_id"`
+	Ok              bool                `tg:"ok,force"`
+	ShippingOptions []tg.ShippingOption `tg:"shipping_options"`
+	ErrorMessage    string              `tg:"error_message"`
+}
+
+// Method returns the method name.
+func (AnswerShippingQuery) Method() string { return "answerShippingQuery" }
+
+// Do performs the request.
+func (p AnswerShippingQuery) Do(ctx context.Context, a *api.API) error {
+	_, err := request[api.Empty](ctx, a, p)
+	return err
+}
+
+// AnswerWebAppQuery contains the parameters of the answerWebAppQuery method.
+//
+// https://core.telegram.org/bots/api#answerwebappquery
+type AnswerWebAppQuery struct {
+	WebAppQueryID string                 `tg:"web_app_query_id"`
+	Result        tg.InlineQueryResulter `tg:"result"`
+}
+
+// Method returns the method name.
+func (AnswerWebAppQuery) Method() string { return "answerWebAppQuery" }
+
+// Do performs the request.
+func (p AnswerWebAppQuery) Do(ctx context.Context, a *api.API) (*tg.SentWebAppMessage, error) {
+	return request[*tg.SentWebAppMessage](ctx, a, p)
+}
+
+// ApproveChatJoinRequest contains the parameters of the approveChatJoinRequest method.
+//
+// https://core.telegram.org/bots/api#approvechatjoinrequest
+type ApproveChatJoinRequest struct {
+	ChatID tg.ChatID `tg:"chat_id"`
+	UserID tg.ID     `tg:"user_id,force"`
+}
+
+// Method returns the method name.
+func (ApproveChatJoinRequest) Method() string { return "approveChatJoinRequest" }
+
+// Do performs the request.
+func (p ApproveChatJoinRequest) Do(ctx context.Context, a *api.API) error {
+	_, err := request[api.Empty](ctx, a, p)
+	return err
+}
+
+// BanChatMember contains the parameters of the banChatMember method.
+//
+// https://core.telegram.org/bots/api#banchatmember
+type BanChatMember struct {
+	ChatID         tg.ChatID `tg:"chat_id"`
+	UserID         tg.ID     `tg:"user_id,force"`
+	UntilDate      tg.Date   `tg:"until_date"`
+	RevokeMessages bool      `tg:"revoke_messages"`
+}
+
+// Method returns the method name.
+func (BanChatMember) Method() string { return "banChatMember" }
+
+// Do performs the request.
+func (p BanChatMember) Do(ctx context.Context, a *api.API) error {
+	_, err := request[api.Empty](ctx, a, p)
+	return err
+}
+
+// BanChatSenderChat contains the parameters of the banChatSenderChat method.
+//
+// https://core.telegram.org/bots/api#banchatsenderchat
+type BanChatSenderChat struct {
+	ChatID       tg.ChatID `tg:"chat_id"`
+	SenderChatID tg.ID     `tg:"sender_chat_id,force"`
+}
+
+// Method returns the method name.
+func (BanChatSenderChat) Method() string { return "banChatSenderChat" }
+
+// Do performs the request.
+func (p BanChatSenderChat) Do(ctx context.Context, a *api.API) error {
+	_, err := request[api.Empty](ctx, a, p)
+	return err
+}
+
+// Close contains the parameters of the close method.
+//
+// https://core.telegram.org/bots/api#close
+type Close struct{}
+
+// Method returns the method name.
+func (Close) Method() string { return "close" }
+
+// Do performs the request.
+func (p Close) Do(ctx context.Context, a *api.API) error {
+	_, err := request[api.Empty](ctx, a, p)
+	return err
+}
+
+// CloseForumTopic contains the parameters of the closeForumTopic method.
+//
+// https://core.telegram.org/bots/api#closeforumtopic
+type CloseForumTopic struct {
+	ChatID          tg.ChatID `tg:"chat_id"`
+	MessageThreadID tg.ID     `tg:"message_thread_id,force"`
+}
+
+// Method returns the method name.
+func (CloseForumTopic) Method() string { return "closeForumTopic" }
+
+// Do performs the request.
+func (p CloseForumTopic) Do(ctx context.Context, a *api.API) error {
+	_, err := request[api.Empty](ctx, a, p)
+	return err
+}
+
+// CloseGeneralForumTopic contains the parameters of the closeGeneralForumTopic method.
+//
+// https://core.telegram.org/bots/api#closegeneralforumtopic
+type CloseGeneralForumTopic struct {
+	ChatID tg.ChatID `tg:"chat_id"`
+}
+
+// Method returns the method name.
+func (CloseGeneralForumTopic) Method() string { return "closeGeneralForumTopic" }
+
+// Do performs the request.
+func (p CloseGeneralForumTopic) Do(ctx context.Context, a *api.API) error {
+	_, err := request[api.Empty](ctx, a, p)
+	return err
+}
+
+// ConvertGiftToStars contains the parameters of the convertGiftToStars method.
+//
+// https://core.telegram.org/bots/api#convertgifttostars
+type ConvertGiftToStars struct {
+	BusinessConnectionID string `tg:"business_connection_id"`
+	OwnedGiftID          string `tg:"owned_gift_id"`
+}
+
+// Method returns the method name.
+func (ConvertGiftToStars) Method() string { return "convertGiftToStars" }
+
+// Do performs the request.
+func (p ConvertGiftToStars) Do(ctx context.Context, a *api.API) error {
+	_, err := request[api.Empty](ctx, a, p)
+	return err
+}
+
+// CopyMessage contains the parameters of the copyMessage method.
+//
+// https://core.telegram.org/bots/api#copymessage
+type CopyMessage struct {
+	ChatID                tg.ChatID           `tg:"chat_id"`
+	MessageThreadID       tg.ID               `tg:"message_thread_id"`
+	FromChatID            tg.ChatID           `tg:"from_chat_id"`
+	MessageID             tg.ID               `tg:"message_id,force"`
+	VideoStartTimestamp   int                 `tg:"video_start_timestamp"`
+	Caption               string              `tg:"caption"`
+	ParseMode             tg.ParseMode        `tg:"parse_mode"`
+	CaptionEntities       []tg.MessageEntity  `tg:"caption_entities"`
+	ShowCaptionAboveMedia bool                `tg:"show_caption_above_media"`
+	DisableNotification   bool                `tg:"disable_notification"`
+	ProtectContent        bool                `tg:"protect_content"`
+	AllowPaidBroadcast    bool                `tg:"allow_paid_broadcast"`
+	ReplyParameters       *tg.ReplyParameters `tg:"reply_parameters"`
+	ReplyMarkup           tg.ReplyMarkup      `tg:"reply_markup"`
+}
+
+// Method returns the method name.
+func (CopyMessage) Method() string { return "copyMessage" }
+
+// Do performs the request.
+func (p CopyMessage) Do(ctx context.Context, a *api.API) (*tg.MessageID, error) {
+	return request[*tg.MessageID](ctx, a, p)
+}
+
+// CopyMessages contains the parameters of the copyMessages method.
+//
+// https://core.telegram.org/bots/api#copymessages
+type CopyMessages struct {
+	ChatID              tg.ChatID `tg:"chat_id"`
+	MessageThreadID     tg.ID     `tg:"message_thread_id"`
+	FromChatID          tg.ChatID `tg:"from_chat_id"`
+	MessageIDs          []tg.ID   `tg:"message_ids"`
+	DisableNotification bool      `tg:"disable_notification"`
+	ProtectContent      bool      `tg:"protect_content"`
+	RemoveCaption       bool      `tg:"remove_caption"`
+}
+
+// Method returns the method name.
+func (CopyMessages) Method() string { return "copyMessages" }
+
+// Do performs the request.
+func (p CopyMessages) Do(ctx context.Context, a *api.API) ([]tg.MessageID, error) {
+	return request[[]tg.MessageID](ctx, a, p)
+}
+
+// CreateChatInviteLink contains the parameters of the createChatInviteLink method.
+//
+// https://core.telegram.org/bots/api#createchatinvitelink
+type CreateChatInviteLink struct {
+	ChatID             tg.ChatID `tg:"chat_id"`
+	Name               string    `tg:"name"`
+	ExpireDate         tg.Date   `tg:"expire_date"`
+	MemberLimit        int       `tg:"member_limit"`
+	CreatesJoinRequest bool      `tg:"creates_join_request"`
+}
+
+// Method returns the method name.
+func (CreateChatInviteLink) Method() string { return "createChatInviteLink" }
+
+// Do performs the request.
+func (p CreateChatInviteLink) Do(ctx context.Context, a *api.API) (*tg.ChatInviteLink, error) {
+	return request[*tg.ChatInviteLink](ctx, a, p)
+}
+
+// CreateChatSubscriptionInviteLink contains the parameters of the createChatSubscriptionInviteLink method.
+//
+// https://core.telegram.org/bots/api#createchatsubscriptioninvitelink
+type CreateChatSubscriptionInviteLink struct {
+	ChatID             tg.ChatID   `tg:"chat_id"`
+	Name               string      `tg:"name"`
+	SubscriptionPeriod tg.Duration `tg:"subscription_period,force"`
+	SubscriptionPrice  int         `tg:"subscription_price,force"`
+}
+
+// Method returns the method name.
+func (CreateChatSubscriptionInviteLink) Method() string { return "createChatSubscriptionInviteLink" }
+
+// Do performs the request.
+func (p CreateChatSubscriptionInviteLink) Do(ctx context.Context, a *api.API) (*tg.ChatInviteLink, error) {
+	return request[*tg.ChatInviteLink](ctx, a, p)
+}
+
+// CreateForumTopic contains the parameters of the createForumTopic method.
+//
+// https://core.telegram.org/bots/api#createforumtopic
+type CreateForumTopic struct {
+	ChatID            tg.ChatID `tg:"chat_id"`
+	Name              string    `tg:"name"`
+	IconColor         int       `tg:"icon_color"`
+	IconCustomEmojiID string    `tg:"icon_custom_emoji_id"`
+}
+
+// Method returns the method name.
+func (CreateForumTopic) Method() string { return "createForumTopic" }
+
+// Do performs the request.
+func (p CreateForumTopic) Do(ctx context.Context, a *api.API) (*tg.ForumTopic, error) {
+	return request[*tg.ForumTopic](ctx, a, p)
+}
+
+// CreateInvoiceLink contains the parameters of the createInvoiceLink method.
+//
+// https://core.telegram.org/bots/api#createinvoicelink
+type CreateInvoiceLink struct {
+	BusinessConnectionID      string            `tg:"business_connection_id"`
+	Title                     string            `tg:"title"`
+	Description               string            `tg:"description"`
+	Payload                   string            `tg:"payload"`
+	ProviderToken             string            `tg:"provider_token"`
+	Currency                  string            `tg:"currency"`
+	Prices                    []tg.LabeledPrice `tg:"prices"`
+	SubscriptionPeriod        tg.Duration       `tg:"subscription_period"`
+	MaxTipAmount              int               `tg:"max_tip_amount"`
+	SuggestedTipAmounts       []int             `tg:"suggested_tip_amounts"`
+	ProviderData              string            `tg:"provider_data"`
+	PhotoURL                  string            `tg:"photo_url"`
+	PhotoSize                 int               `tg:"photo_size"`
+	PhotoWidth                int               `tg:"photo_width"`
+	PhotoHeight               int               `tg:"photo_height"`
+	NeedName                  bool              `tg:"need_name"`
+	NeedPhoneNumber           bool              `tg:"need_phone_number"`
+	NeedEmail                 bool              `tg:"need_email"`
+	NeedShippingAddress       bool              `tg:"need_shipping_address"`
+	SendPhoneNumberToProvider bool              `tg:"send_phone_number_to_provider"`
+	SendEmailToProvider       bool              `tg:"send_email_to_provider"`
+	IsFlexible                bool              `tg:"is_flexible"`
+}
+
+// Method returns the method name.
+func (CreateInvoiceLink) Method() string { return "createInvoiceLink" }
+
+// Do performs the request.
+func (p CreateInvoiceLink) Do(ctx context.Context, a *api.API) (string, error) {
+	return request[string](ctx, a, p)
+}
+
+// CreateNewStickerSet contains the parameters of the createNewStickerSet method.
+//
+// https://core.telegram.org/bots/api#createnewstickerset
+type CreateNewStickerSet struct {
+	UserID          tg.ID             `tg:"user_id,force"`
+	Name            string            `tg:"name"`
+	Title           string            `tg:"title"`
+	Stickers        []tg.InputSticker `tg:"stickers"`
+	StickerType     string            `tg:"sticker_type"`
+	NeedsRepainting bool              `tg:"needs_repainting"`
+}
+
+// Method returns the method name.
+func (CreateNewStickerSet) Method() string { return "createNewStickerSet" }
+
+// Do performs the request.
+func (p CreateNewStickerSet) Do(ctx context.Context, a *api.API) error {
+	_, err := request[api.Empty](ctx, a, p)
+	return err
+}
+
+// DeclineChatJoinRequest contains the parameters of the declineChatJoinRequest method.
+//
+// https://core.telegram.org/bots/api#declinechatjoinrequest
+type DeclineChatJoinRequest struct {
+	ChatID tg.ChatID `tg:"chat_id"`
+	UserID tg.ID     `tg:"user_id,force"`
+}
+
+// Method returns the method name.
+func (DeclineChatJoinRequest) Method() string { return "declineChatJoinRequest" }
+
+// Do performs the request.
+func (p DeclineChatJoinRequest) Do(ctx context.Context, a *api.API) error {
+	_, err := request[api.Empty](ctx, a, p)
+	return err
+}
+
+// DeleteBusinessMessages contains the parameters of the deleteBusinessMessages method.
+//
+// https://core.telegram.org/bots/api#deletebusinessmessages
+type DeleteBusinessMessages struct {
+	BusinessConnectionID string  `tg:"business_connection_id"`
+	MessageIDs           []tg.ID `tg:"message_ids"`
+}
+
+// Method returns the method name.
+func (DeleteBusinessMessages) Method() string { return "deleteBusinessMessages" }
+
+// Do performs the request.
+func (p DeleteBusinessMessages) Do(ctx context.Context, a *api.API) error {
+	_, err := request[api.Empty](ctx, a, p)
+	return err
+}
+
+// DeleteChatPhoto contains the parameters of the deleteChatPhoto method.
+//
+// https://core.telegram.org/bots/api#deletechatphoto
+type DeleteChatPhoto struct {
+	ChatID tg.ChatID `tg:"chat_id"`
+}
+
+// Method returns the method name.
+func (DeleteChatPhoto) Method() string { return "deleteChatPhoto" }
+
+// Do performs the request.
+func (p DeleteChatPhoto) Do(ctx context.Context, a *api.API) error {
+	_, err := request[api.Empty](ctx, a, p)
+	return err
+}
+
+// DeleteChatStickerSet contains the parameters of the deleteChatStickerSet method.
+//
+// https://core.telegram.org/bots/api#deletechatstickerset
+type DeleteChatStickerSet struct {
+	ChatID tg.ChatID `tg:"chat_id"`
+}
+
+// Method returns the method name.
+func (DeleteChatStickerSet) Method() string { return "deleteChatStickerSet" }
+
+// Do performs the request.
+func (p DeleteChatStickerSet) Do(ctx context.Context, a *api.API) error {
+	_, err := request[api.Empty](ctx, a, p)
+	return err
+}
+
+// DeleteForumTopic contains the parameters of the deleteForumTopic method.
+//
+// https://core.telegram.org/bots/api#deleteforumtopic
+type DeleteForumTopic struct {
+	ChatID          tg.ChatID `tg:"chat_id"`
+	MessageThreadID tg.ID     `tg:"message_thread_id,force"`
+}
+
+// Method returns the method name.
+func (DeleteForumTopic) Method() string { return "deleteForumTopic" }
+
+// Do performs the request.
+func (p DeleteForumTopic) Do(ctx context.Context, a *api.API) error {
+	_, err := request[api.Empty](ctx, a, p)
+	return err
+}
+
+// DeleteMessage contains the parameters of the deleteMessage method.
+//
+// https://core.telegram.org/bots/api#deletemessage
+type DeleteMessage struct {
+	ChatID    tg.ChatID `tg:"chat_id"`
+	MessageID tg.ID     `tg:"message_id,force"`
+}
+
+// Method returns the method name.
+func (DeleteMessage) Method() string { return "deleteMessage" }
+
+// Do performs the request.
+func (p DeleteMessage) Do(ctx context.Context, a *api.API) error {
+	_, err := request[api.Empty](ctx, a, p)
+	return err
+}
+
+// DeleteMessages contains the parameters of the deleteMessages method.
+//
+// https://core.telegram.org/bots/api#deletemessages
+type DeleteMessages struct {
+	ChatID     tg.ChatID `tg:"chat_id"`
+	MessageIDs []tg.ID   `tg:"message_ids"`
+}
+
+// Method returns the method name.
+func (DeleteMessages) Method() string { return "deleteMessages" }
+
+// Do performs the request.
+func (p DeleteMessages) Do(ctx context.Context, a *api.API) error {
+	_, err := request[api.Empty](ctx, a, p)
+	return err
+}
+
+// DeleteMyCommands contains the parameters of the deleteMyCommands method.
+//
+// https://core.telegram.org/bots/api#deletemycommands
+type DeleteMyCommands struct {
+	Scope        *tg.CommandScope `tg:"scope"`
+	LanguageCode string           `tg:"language_code"`
+}
+
+// Method returns the method name.
+func (DeleteMyCommands) Method() string { return "deleteMyCommands" }
+
+// Do performs the request.
+func (p DeleteMyCommands) Do(ctx context.Context, a *api.API) error {
+	_, err := request[api.Empty](ctx, a, p)
+	return err
+}
+
+// DeleteStickerFromSet contains the parameters of the deleteStickerFromSet method.
+//
+// https://core.telegram.org/bots/api#deletestickerfromset
+type DeleteStickerFromSet struct {
+	Sticker string `tg:"sticker"`
+}
+
+// Method returns the method name.
+func (DeleteStickerFromSet) Method() string { return "deleteStickerFromSet" }
+
+// Do performs the request.
+func (p DeleteStickerFromSet) Do(ctx context.Context, a *api.API) error {
+	_, err := request[api.Empty](ctx, a, p)
+	return err
+}
+
+// DeleteStickerSet contains the parameters of the deleteStickerSet method.
+//
+// https://core.telegram.org/bots/api#deletestickerset
+type DeleteStickerSet struct {
+	Name string `tg:"name"`
+}
+
+// Method returns the method name.
+func (DeleteStickerSet) Method() string { return "deleteStickerSet" }
+
+// Do performs the request.
+func (p DeleteStickerSet) Do(ctx context.Context, a *api.API) error {
+	_, err := request[api.Empty](ctx, a, p)
+	return err
+}
+
+// DeleteStory contains the parameters of the deleteStory method.
+//
+// https://core.telegram.org/bots/api#deletestory
+type DeleteStory struct {
+	BusinessConnectionID string `tg:"business_connection_id"`
+	StoryID              tg.ID  `tg:"story_id,force"`
+}
+
+// Method returns the method name.
+func (DeleteStory) Method() string { return "deleteStory" }
+
+// Do performs the request.
+func (p DeleteStory) Do(ctx context.Context, a *api.API) error {
+	_, err := request[api.Empty](ctx, a, p)
+	return err
+}
+
+// DeleteWebhook contains the parameters of the deleteWebhook method.
+//
+// https://core.telegram.org/bots/api#deletewebhook
+type DeleteWebhook struct {
+	DropPendingUpdates bool `tg:"drop_pending_updates"`
+}
+
+// Method returns the method name.
+func (DeleteWebhook) Method() string { return "deleteWebhook" }
+
+// Do performs the request.
+func (p DeleteWebhook) Do(ctx context.Context, a *api.API) error {
+	_, err := request[api.Empty](ctx, a, p)
+	return err
+}
+
+// EditChatInviteLink contains the parameters of the editChatInviteLink method.
+//
+// https://core.telegram.org/bots/api#editchatinvitelink
+type EditChatInviteLink struct {
+	ChatID             tg.ChatID `tg:"chat_id"`
+	InviteLink         string    `tg:"invite_link"`
+	Name               string    `tg:"name"`
+	ExpireDate         tg.Date   `tg:"expire_date"`
+	MemberLimit        int       `tg:"member_limit"`
+	CreatesJoinRequest bool      `tg:"creates_join_request"`
+}
+
+// Method returns the method name.
+func (EditChatInviteLink) Method() string { return "editChatInviteLink" }
+
+// Do performs the request.
+func (p EditChatInviteLink) Do(ctx context.Context, a *api.API) (*tg.ChatInviteLink, error) {
+	return request[*tg.ChatInviteLink](ctx, a, p)
+}
+
+// EditChatSubscriptionInviteLink contains the parameters of the editChatSubscriptionInviteLink method.
+//
+// https://core.telegram.org/bots/api#editchatsubscriptioninvitelink
+type EditChatSubscriptionInviteLink struct {
+	ChatID     tg.ChatID `tg:"chat_id"`
+	InviteLink string    `tg:"invite_link"`
+	Name       string    `tg:"name"`
+}
+
+// Method returns the method name.
+func (EditChatSubscriptionInviteLink) Method() string { return "editChatSubscriptionInviteLink" }
+
+// Do performs the request.
+func (p EditChatSubscriptionInviteLink) Do(ctx context.Context, a *api.API) (*tg.ChatInviteLink, error) {
+	return request[*tg.ChatInviteLink](ctx, a, p)
+}
+
+// EditForumTopic contains the parameters of the editForumTopic method.
+//
+// https://core.telegram.org/bots/api#editforumtopic
+type EditForumTopic struct {
+	ChatID            tg.ChatID `tg:"chat_id"`
+	MessageThreadID   tg.ID     `tg:"message_thread_id,force"`
+	Name              string    `tg:"name"`
+	IconCustomEmojiID string    `tg:"icon_custom_emoji_id"`
+}
+
+// Method returns the method name.
+func (EditForumTopic) Method() string { return "editForumTopic" }
+
+// Do performs the request.
+func (p EditForumTopic) Do(ctx context.Context, a *api.API) error {
+	_, err := request[api.Empty](ctx, a, p)
+	return err
+}
+
+// EditGeneralForumTopic contains the parameters of the editGeneralForumTopic method.
+//
+// https://core.telegram.org/bots/api#editgeneralforumtopic
+type EditGeneralForumTopic struct {
+	ChatID tg.ChatID `tg:"chat_id"`
+	Name   string    `tg:"name"`
+}
+
+// Method returns the method name.
+func (EditGeneralForumTopic) Method() string { return "editGeneralForumTopic" }
+
+// Do performs the request.
+func (p EditGeneralForumTopic) Do(ctx context.Context, a *api.API) error {
+	_, err := request[api.Empty](ctx, a, p)
+	return err
+}
+
+// EditMessageCaption contains the parameters of the editMessageCaption method.
+//
+// https://core.telegram.org/bots/api#editmessagecaption
+type EditMessageCaption struct {
+	BusinessConnectionID  string                   `tg:"business_connection_id"`
+	ChatID                tg.ChatID                `tg:"chat_id"`
+	MessageID             tg.ID                    `tg:"message_id"`
+	InlineMessageID       string                   `tg:"inline_message_id"`
+	Caption               string                   `tg:"caption"`
+	ParseMode             tg.ParseMode             `tg:"parse_mode"`
+	CaptionEntities       []tg.MessageEntity       `tg:"caption_entities"`
+	ShowCaptionAboveMedia bool                     `tg:"show_caption_above_media"`
+	ReplyMarkup           *tg.InlineKeyboardMarkup `tg:"reply_markup"`
+}
+
+// Method returns the method name.
+func (EditMessageCaption) Method() string { return "editMessageCaption" }
+
+// Do performs the request.
+// If the message is inline, the result is nil.
+func (p EditMessageCaption) Do(ctx context.Context, a *api.API) (*tg.Message, error) {
+	if p.InlineMessageID != "" {
+		_, err := request[api.Empty](ctx, a, p)
+		return nil, err
+	}
+	return request[*tg.Message](ctx, a, p)
+}
+
+// EditMessageChecklist contains the parameters of the editMessageChecklist method.
+//
+// https://core.telegram.org/bots/api#editmessagechecklist
+type EditMessageChecklist struct {
+	BusinessConnectionID string                   `tg:"business_connection_id"`
+	ChatID               tg.ID                    `tg:"chat_id,force"`
+	MessageID            tg.ID                    `tg:"message_id,force"`
+	Checklist            tg.InputChecklist        `tg:"checklist"`
+	ReplyMarkup          *tg.InlineKeyboardMarkup `tg:"reply_markup"`
+}
+
+// Method returns the method name.
+func (EditMessageChecklist) Method() string { return "editMessageChecklist" }
+
+// Do performs the request.
+func (p EditMessageChecklist) Do(ctx context.Context, a *api.API) (*tg.Message, error) {
+	return request[*tg.Message](ctx, a, p)
+}
+
+// EditMessageLiveLocation contains the parameters of the editMessageLiveLocation method.
+//
+// https://core.telegram.org/bots/api#editmessagelivelocation
+type EditMessageLiveLocation struct {
+	BusinessConnectionID string                   `tg:"business_connection_id"`
+	ChatID               tg.ChatID                `tg:"chat_id"`
+	MessageID            tg.ID                    `tg:"message_id"`
+	InlineMessageID      string                   `tg:"inline_message_id"`
+	Latitude             float64                  `tg:"latitude,force"`
+	Longitude            float64                  `tg:"longitude,force"`
+	LivePeriod           tg.Duration              `tg:"live_period"`
+	HorizontalAccuracy   float64                  `tg:"horizontal_accuracy"`
+	Heading              int                      `tg:"heading"`
+	ProximityAlertRadius int                      `tg:"proximity_alert_radius"`
+	ReplyMarkup          *tg.InlineKeyboardMarkup `tg:"reply_markup"`
+}
+
+// Method returns the method name.
+func (EditMessageLiveLocation) Method() string { return "editMessageLiveLocation" }
+
+// Do performs the request.
+// If the message is inline, the result is nil.
+func (p EditMessageLiveLocation) Do(ctx context.Context, a *api.API) (*tg.Message, error) {
+	if p.InlineMessageID != "" {
+		_, err := request[api.Empty](ctx, a, p)
+		return nil, err
+	}
+	return request[*tg.Message](ctx, a, p)
+}
+
+// EditMessageMedia contains the parameters of the editMessageMedia method.
+//
+// https://core.telegram.org/bots/api#editmessagemedia
+type EditMessageMedia struct {
+	BusinessConnectionID string                   `tg:"business_connection_id"`
+	ChatID               tg.ChatID                `tg:"chat_id"`
+	MessageID            tg.ID                    `tg:"message_id"`
+	InlineMessageID      string                   `tg:"inline_message_id"`
+	Media                tg.InputMedia            `tg:"media"`
+	ReplyMarkup          *tg.InlineKeyboardMarkup `tg:"reply_markup"`
+}
+
+// Method returns the method name.
+func (EditMessageMedia) Method() string { return "editMessageMedia" }
+
+// Do performs the request.
+// If the message is inline, the result is nil.
+func (p EditMessageMedia) Do(ctx context.Context, a *api.API) (*tg.Message, error) {
+	if p.InlineMessageID != "" {
+		_, err := request[api.Empty](ctx, a, p)
+		return nil, err
+	}
+	return request[*tg.Message](ctx, a, p)
+}
+
+// EditMessageReplyMarkup contains the parameters of the editMessageReplyMarkup method.
+//
+// https://core.telegram.org/bots/api#editmessagereplymarkup
+type EditMessageReplyMarkup struct {
+	BusinessConnectionID string                   `tg:"business_connection_id"`
+	ChatID               tg.ChatID                `tg:"chat_id"`
+	MessageID            tg.ID                    `tg:"message_id"`
+	InlineMessageID      string                   `tg:"inline_message_id"`
+	ReplyMarkup          *tg.InlineKeyboardMarkup `tg:"reply_markup"`
+}
+
+// Method returns the method name.
+func (EditMessageReplyMarkup) Method() string { return "editMessageReplyMarkup" }
+
+// Do performs the request.
+// If the message is inline, the result is nil.
+func (p EditMessageReplyMarkup) Do(ctx context.Context, a *api.API) (*tg.Message, error) {
+	if p.InlineMessageID != "" {
+		_, err := request[api.Empty](ctx, a, p)
+		return nil, err
+	}
+	return request[*tg.Message](ctx, a, p)
+}
+
+// EditMessageText contains the parameters of the editMessageText method.
+//
+// https://core.telegram.org/bots/api#editmessagetext
+type EditMessageText struct {
+	BusinessConnectionID string                   `tg:"business_connection_id"`
+	ChatID               tg.ChatID                `tg:"chat_id"`
+	MessageID            tg.ID                    `tg:"message_id"`
+	InlineMessageID      string                   `tg:"inline_message_id"`
+	Text                 string                   `tg:"text"`
+	ParseMode            tg.ParseMode             `tg:"parse_mode"`
+	Entities             []tg.MessageEntity       `tg:"entities"`
+	LinkPreviewOptions   *tg.LinkPreviewOptions   `tg:"link_preview_options"`
+	ReplyMarkup          *tg.InlineKeyboardMarkup `tg:"reply_markup"`
+}
+
+// Method returns the method name.
+func (EditMessageText) Method() string { return "editMessageText" }
+
+// Do performs the request.
+// If the message is inline, the result is nil.
+func (p EditMessageText) Do(ctx context.Context, a *api.API) (*tg.Message, error) {
+	if p.InlineMessageID != "" {
+		_, err := request[api.Empty](ctx, a, p)
+		return nil, err
+	}
+	return request[*tg.Message](ctx, a, p)
+}
+
+// EditStory contains the parameters of the editStory method.
+//
+// https://core.telegram.org/bots/api#editstory
+type EditStory struct {
+	BusinessConnectionID string               `tg:"business_connection_id"`
+	StoryID              tg.ID                `tg:"story_id,force"`
+	Content              tg.InputStoryContent `tg:"content"`
+	Caption              string               `tg:"caption"`
+	ParseMode            tg.ParseMode         `tg:"parse_mode"`
+	CaptionEntities      []tg.MessageEntity   `tg:"caption_entities"`
+	Areas                []tg.StoryArea       `tg:"areas"`
+}
+
+// Method returns the method name.
+func (EditStory) Method() string { return "editStory" }
+
+// Do performs the request.
+func (p EditStory) Do(ctx context.Context, a *api.API) (*tg.Story, error) {
+	return request[*tg.Story](ctx, a, p)
+}
+
+// EditUserStarSubscription contains the parameters of the editUserStarSubscription method.
+//
+// https://core.telegram.org/bots/api#edituserstarsubscription
+type EditUserStarSubscription struct {
+	UserID                  tg.ID  `tg:"user_id,force"`
+	TelegramPaymentChargeID string `tg:"telegram_payment_charge_id"`
+	IsCanceled              bool   `tg:"is_canceled,force"`
+}
+
+// Method returns the method name.
+func (EditUserStarSubscription) Method() string { return "editUserStarSubscription" }
+
+// Do performs the request.
+func (p EditUserStarSubscription) Do(ctx context.Context, a *api.API) error {
+	_, err := request[api.Empty](ctx, a, p)
+	return err
+}
+
+// ExportChatInviteLink contains the parameters of the exportChatInviteLink method.
+//
+// https://core.telegram.org/bots/api#exportchatinvitelink
+type ExportChatInviteLink struct {
+	ChatID tg.ChatID `tg:"chat_id"`
+}
+
+// Method returns the method name.
+func (ExportChatInviteLink) Method() string { return "exportChatInviteLink" }
+
+// Do performs the request.
+func (p ExportChatInviteLink) Do(ctx context.Context, a *api.API) (string, error) {
+	return request[string](ctx, a, p)
+}
+
+// ForwardMessage contains the parameters of the forwardMessage method.
+//
+// https://core.telegram.org/bots/api#forwardmessage
+type ForwardMessage struct {
+	ChatID              tg.ChatID `tg:"chat_id"`
+	MessageThreadID     tg.ID     `tg:"message_thread_id"`
+	FromChatID          tg.ChatID `tg:"from_chat_id"`
+	VideoStartTimestamp int       `tg:"video_start_timestamp"`
+	DisableNotification bool      `tg:"disable_notification"`
+	ProtectContent      bool      `tg:"protect_content"`
+	MessageID           tg.ID     `tg:"message_id,force"`
+}
+
+// Method returns the method name.
+func (ForwardMessage) Method() string { return "forwardMessage" }
+
+// Do performs the request.
+func (p ForwardMessage) Do(ctx context.Context, a *api.API) (*tg.Message, error) {
+	return request[*tg.Message](ctx, a, p)
+}
+
+// ForwardMessages contains the parameters of the forwardMessages method.
+//
+// https://core.telegram.org/bots/api#forwardmessages
+type ForwardMessages struct {
+	ChatID              tg.ChatID `tg:"chat_id"`
+	MessageThreadID     tg.ID     `tg:"message_thread_id"`
+	FromChatID          tg.ChatID `tg:"from_chat_id"`
+	MessageIDs          []tg.ID   `tg:"message_ids"`
+	DisableNotification bool      `tg:"disable_notification"`
+	ProtectContent      bool      `tg:"protect_content"`
+}
+
+// Method returns the method name.
+func (ForwardMessages) Method() string { return "forwardMessages" }
+
+// Do performs the request.
+func (p ForwardMessages) Do(ctx context.Context, a *api.API) ([]tg.MessageID, error) {
+	return request[[]tg.MessageID](ctx, a, p)
+}
+
+// GetAvailableGifts contains the parameters of the getAvailableGifts method.
+//
+// https://core.telegram.org/bots/api#getavailablegifts
+type GetAvailableGifts struct{}
+
+// Method returns the method name.
+func (GetAvailableGifts) Method() string { return "getAvailableGifts" }
+
+// Do performs the request.
+func (p GetAvailableGifts) Do(ctx context.Context, a *api.API) (*tg.Gifts, error) {
+	return request[*tg.Gifts](ctx, a, p)
+}
+
+// GetBusinessAccountGifts contains the parameters of the getBusinessAccountGifts method.
+//
+// https://core.telegram.org/bots/api#getbusinessaccountgifts
+type GetBusinessAccountGifts struct {
+	BusinessConnectionID string `tg:"business_connection_id"`
+	ExcludeUnsaved       bool   `tg:"exclude_unsaved"`
+	ExcludeSaved         bool   `tg:"exclude_saved"`
+	ExcludeUnlimited     bool   `tg:"exclude_unlimited"`
+	ExcludeLimited       bool   `tg:"exclude_limited"`
+	ExcludeUnique        bool   `tg:"exclude_unique"`
+	SortByPrice          bool   `tg:"sort_by_price"`
+	Offset               string `tg:"offset"`
+	Limit                int    `tg:"limit"`
+}
+
+// Method returns the method name.
+func (GetBusinessAccountGifts) Method() string { return "getBusinessAccountGifts" }
+
+// Do performs the request.
+func (p GetBusinessAccountGifts) Do(ctx context.Context, a *api.API) (*tg.OwnedGifts, error) {
+	return request[*tg.OwnedGifts](ctx, a, p)
+}
+
+// GetBusinessAccountStarBalance contains the parameters of the getBusinessAccountStarBalance method.
+//
+// https://core.telegram.org/bots/api#getbusinessaccountstarbalance
+type GetBusinessAccountStarBalance struct {
+	BusinessConnectionID string `tg:"business_connection_id"`
+}
+
+// Method returns the method name.
+func (GetBusinessAccountStarBalance) Method() string { return "getBusinessAccountStarBalance" }
+
+// Do performs the request.
+func (p GetBusinessAccountStarBalance) Do(ctx context.Context, a *api.API) (*tg.StarAmount, error) {
+	return request[*tg.StarAmount](ctx, a, p)
+}
+
+// GetBusinessConnection contains the parameters of the getBusinessConnection method.
+//
+// https://core.telegram.org/bots/api#getbusinessconnection
+type GetBusinessConnection struct {
+	BusinessConnectionID string `tg:"business_connection_id"`
+}
+
+// Method returns the method name.
+func (GetBusinessConnection) Method() string { return "getBusinessConnection" }
+
+// Do performs the request.
+func (p GetBusinessConnection) Do(ctx context.Context, a *api.API) (*tg.BusinessConnection, error) {
+	return request[*tg.BusinessConnection](ctx, a, p)
+}
+
+// GetChat contains the parameters of the getChat method.
+//
+// https://core.telegram.org/bots/api#getchat
+type GetChat struct {
+	ChatID tg.ChatID `tg:"chat_id"`
+}
+
+// Method returns the method name.
+func (GetChat) Method() string { return "getChat" }
+
+// Do performs the request.
+func (p GetChat) Do(ctx context.Context, a *api.API) (*tg.ChatFullInfo, error) {
+	return request[*tg.ChatFullInfo](ctx, a, p)
+}
+
+// GetChatAdministrators contains the parameters of the getChatAdministrators method.
+//
+// https://core.telegram.org/bots/api#getchatadministrators
+type GetChatAdministrators struct {
+	ChatID tg.ChatID `tg:"chat_id"`
+}
+
+// Method returns the method name.
+func (GetChatAdministrators) Method() string { return "getChatAdministrators" }
+
+// Do performs the request.
+func (p GetChatAdministrators) Do(ctx context.Context, a *api.API) ([]tg.ChatMember, error) {
+	return request[[]tg.ChatMember](ctx, a, p)
+}
+
+// GetChatMember contains the parameters of the getChatMember method.
+//
+// https://core.telegram.org/bots/api#getchatmember
+type GetChatMember struct {
+	ChatID tg.ChatID `tg:"chat_id"`
+	UserID tg.ID     `tg:"user_id,force"`
+}
+
+// Method returns the method name.
+func (GetChatMember) Method() string { return "getChatMember" }
+
+// Do performs the request.
+func (p GetChatMember) Do(ctx context.Context, a *api.API) (*tg.ChatMember, error) {
+	return request[*tg.ChatMember](ctx, a, p)
+}
+
+// GetChatMemberCount contains the parameters of the getChatMemberCount method.
+//
+// https://core.telegram.org/bots/api#getchatmembercount
+type GetChatMemberCount struct {
+	ChatID tg.ChatID `tg:"chat_id"`
+}
+
+// Method returns the method name.
+func (GetChatMemberCount) Method() string { return "getChatMemberCount" }
+
+// Do performs the request.
+func (p GetChatMemberCount) Do(ctx context.Context, a *api.API) (int, error) {
+	return request[int](ctx, a, p)
+}
+
+// GetChatMenuButton contains the parameters of the getChatMenuButton method.
+//
+// https://core.telegram.org/bots/api#getchatmenubutton
+type GetChatMenuButton struct {
+	ChatID tg.ID `tg:"chat_id"`
+}
+
+// Method returns the method name.
+func (GetChatMenuButton) Method() string { return "getChatMenuButton" }
+
+// Do performs the request.
+func (p GetChatMenuButton) Do(ctx context.Context, a *api.API) (*tg.MenuButton, error) {
+	return request[*tg.MenuButton](ctx, a, p)
+}
+
+// GetCustomEmojiStickers contains the parameters of the getCustomEmojiStickers method.
+//
+// https://core.telegram.org/bots/api#getcustomemojistickers
+type GetCustomEmojiStickers struct {
+	CustomEmojiIDs []string `tg:"custom_emoji_ids"`
+}
+
+// Method returns the method name.
+func (GetCustomEmojiStickers) Method() string { return "getCustomEmojiStickers" }
+
+// Do performs the request.
+func (p GetCustomEmojiStickers) Do(ctx context.Context, a *api.API) ([]tg.Sticker, error) {
+	return request[[]tg.Sticker](ctx, a, p)
+}
+
+// GetFile contains the parameters of the getFile method.
+//
+// https://core.telegram.org/bots/api#getfile
+type GetFile struct {
+	FileID string `tg:"file_id"`
+}
+
+// Method returns the method name.
+func (GetFile) Method() string { return "getFile" }
+
+// Do performs the request.
+func (p GetFile) Do(ctx context.Context, a *api.API) (*tg.File, error) {
+	return request[*tg.File](ctx, a, p)
+}
+
+// GetForumTopicIconStickers contains the parameters of the getForumTopicIconStickers method.
+//
+// https://core.telegram.org/bots/api#getforumtopiciconstickers
+type GetForumTopicIconStickers struct{}
+
+// Method returns the method name.
+func (GetForumTopicIconStickers) Method() string { return "getForumTopicIconStickers" }
+
+// Do performs the request.
+func (p GetForumTopicIconStickers) Do(ctx context.Context, a *api.API) ([]tg.Sticker, error) {
+	return request[[]tg.Sticker](ctx, a, p)
+}
+
+// GetGameHighScores contains the parameters of the getGameHighScores method.
+//
+// https://core.telegram.org/bots/api#getgamehighscores
+type GetGameHighScores struct {
+	UserID          tg.ID  `tg:"user_id,force"`
+	ChatID          tg.ID  `tg:"chat_id"`
+	MessageID       tg.ID  `tg:"message_id"`
+	InlineMessageID string `tg:"inline_message_id"`
+}
+
+// Method returns the method name.
+func (GetGameHighScores) Method() string { return "getGameHighScores" }
+
+// Do performs the request.
+func (p GetGameHighScores) Do(ctx context.Context, a *api.API) ([]tg.GameHighScore, error) {
+	return request[[]tg.GameHighScore](ctx, a, p)
+}
+
+// GetMe contains the parameters of the getMe method.
+//
+// https://core.telegram.org/bots/api#getme
+type GetMe struct{}
+
+// Method returns the method name.
+func (GetMe) Method() string { return "getMe" }
+
+// Do performs the request.
+func (p GetMe) Do(ctx context.Context, a *api.API) (*tg.User, error) {
+	return request[*tg.User](ctx, a, p)
+}
+
+// GetMyCommands contains the parameters of the getMyCommands method.
+//
+// https://core.telegram.org/bots/api#getmycommands
+type GetMyCommands struct {
+	Scope        *tg.CommandScope `tg:"scope"`
+	LanguageCode string           `tg:"language_code"`
+}
+
+// Method returns the method name.
+func (GetMyCommands) Method() string { return "getMyCommands" }
+
+// Do performs the request.
+func (p GetMyCommands) Do(ctx context.Context, a *api.API) ([]tg.Command, error) {
+	return request[[]tg.Command](ctx, a, p)
+}
+
+// GetMyDefaultAdministratorRights contains the parameters of the getMyDefaultAdministratorRights method.
+//
+// https://core.telegram.org/bots/api#getmydefaultadministratorrights
+type GetMyDefaultAdministratorRights struct {
+	ForChannels bool `tg:"for_channels"`
+}
+
+// Method returns the method name.
+func (GetMyDefaultAdministratorRights) Method() string { return "getMyDefaultAdministratorRights" }
+
+// Do performs the request.
+func (p GetMyDefaultAdministratorRights) Do(ctx context.Context, a *api.API) (*tg.ChatAdministratorRights, error) {
+	return request[*tg.ChatAdministratorRights](ctx, a, p)
+}
+
+// GetMyDescription contains the parameters of the getMyDescription method.
+//
+// https://core.telegram.org/bots/api#getmydescription
+type GetMyDescription struct {
+	LanguageCode string `tg:"language_code"`
+}
+
+// Method returns the method name.
+func (GetMyDescription) Method() string { return "getMyDescription" }
+
+// Do performs the request.
+func (p GetMyDescription) Do(ctx context.Context, a *api.API) (*tg.BotDescription, error) {
+	return request[*tg.BotDescription](ctx, a, p)
+}
+
+// GetMyName contains the parameters of the getMyName method.
+//
+// https://core.telegram.org/bots/api#getmyname
+type GetMyName struct {
+	LanguageCode string `tg:"language_code"`
+}
+
+// Method returns the method name.
+func (GetMyName) Method() string { return "getMyName" }
+
+// Do performs the request.
+func (p GetMyName) Do(ctx context.Context, a *api.API) (*tg.BotName, error) {
+	return request[*tg.BotName](ctx, a, p)
+}
+
+// GetMyShortDescription contains the parameters of the getMyShortDescription method.
+//
+// https://core.telegram.org/bots/api#getmyshortdescription
+type GetMyShortDescription struct {
+	LanguageCode string `tg:"language_code"`
+}
+
+// Method returns the method name.
+func (GetMyShortDescription) Method() string { return "getMyShortDescription" }
+
+// Do performs the request.
+func (p GetMyShortDescription) Do(ctx context.Context, a *api.API) (*tg.BotShortDescription, error) {
+	return request[*tg.BotShortDescription](ctx, a, p)
+}
+
+// GetMyStarBalance contains the parameters of the getMyStarBalance method.
+//
+// https://core.telegram.org/bots/api#getmystarbalance
+type GetMyStarBalance struct{}
+
+// Method returns the method name.
+func (GetMyStarBalance) Method() string { return "getMyStarBalance" }
+
+// Do performs the request.
+func (p GetMyStarBalance) Do(ctx context.Context, a *api.API) (*tg.StarAmount, error) {
+	return request[*tg.StarAmount](ctx, a, p)
+}
+
+// GetStarTransactions contains the parameters of the getStarTransactions method.
+//
+// https://core.telegram.org/bots/api#getstartransactions
+type GetStarTransactions struct {
+	Offset int `tg:"offset"`
+	Limit  int `tg:"limit"`
+}
+
+// Method returns the method name.
+func (GetStarTransactions) Method() string { return "getStarTransactions" }
+
+// Do performs the request.
+func (p GetStarTransactions) Do(ctx context.Context, a *api.API) (*tg.StarTransactions, error) {
+	return request[*tg.StarTransactions](ctx, a, p)
+}
+
+// GetStickerSet contains the parameters of the getStickerSet method.
+//
+// https://core.telegram.org/bots/api#getstickerset
+type GetStickerSet struct {
+	Name string `tg:"name"`
+}
+
+// Method returns the method name.
+func (GetStickerSet) Method() string { return "getStickerSet" }
+
+// Do performs the request.
+func (p GetStickerSet) Do(ctx context.Context, a *api.API) (*tg.StickerSet, error) {
+	return request[*tg.StickerSet](ctx, a, p)
+}
+
+// GetUpdates contains the parameters of the getUpdates method.
+//
+// https://core.telegram.org/bots/api#getupdates
+type GetUpdates struct {
+	Offset         int      `tg:"offset"`
+	Limit          int      `tg:"limit"`
+	Timeout        int      `tg:"timeout"`
+	AllowedUpdates []string `tg:"allowed_updates"`
+}
+
+// Method returns the method name.
+func (GetUpdates) Method() string { return "getUpdates" }
+
+// Do performs the request.
+func (p GetUpdates) Do(ctx context.Context, a *api.API) ([]tg.Update, error) {
+	return request[[]tg.Update](ctx, a, p)
+}
+
+// GetUserChatBoosts contains the parameters of the getUserChatBoosts method.
+//
+// https://core.telegram.org/bots/api#getuserchatboosts
+type GetUserChatBoosts struct {
+	ChatID tg.ChatID `tg:"chat_id"`
+	UserID tg.ID     `tg:"user_id,force"`
+}
+
+// Method returns the method name.
+func (GetUserChatBoosts) Method() string { return "getUserChatBoosts" }
+
+// Do performs the request.
+func (p GetUserChatBoosts) Do(ctx context.Context, a *api.API) (*tg.UserChatBoosts, error) {
+	return request[*tg.UserChatBoosts](ctx, a, p)
+}
+
+// GetUserProfilePhotos contains the parameters of the getUserProfilePhotos method.
+//
+// https://core.telegram.org/bots/api#getuserprofilephotos
+type GetUserProfilePhotos struct {
+	UserID tg.ID `tg:"user_id,force"`
+	Offset int   `tg:"offset"`
+	Limit  int   `tg:"limit"`
+}
+
+// Method returns the method name.
+func (GetUserProfilePhotos) Method() string { return "getUserProfilePhotos" }
+
+// Do performs the request.
+func (p GetUserProfilePhotos) Do(ctx context.Context, a *api.API) (*tg.UserProfilePhotos, error) {
+	return request[*tg.UserProfilePhotos](ctx, a, p)
+}
+
+// GetWebhookInfo contains the parameters of the getWebhookInfo method.
+//
+// https://core.telegram.org/bots/api#getwebhookinfo
+type GetWebhookInfo struct{}
+
+// Method returns the method name.
+func (GetWebhookInfo) Method() string { return "getWebhookInfo" }
+
+// Do performs the request.
+func (p GetWebhookInfo) Do(ctx context.Context, a *api.API) (*tg.WebhookInfo, error) {
+	return request[*tg.WebhookInfo](ctx, a, p)
+}
+
+// GiftPremiumSubscription contains the parameters of the giftPremiumSubscription method.
+//
+// https://core.telegram.org/bots/api#giftpremiumsubscription
+type GiftPremiumSubscription struct {
+	UserID        tg.ID              `tg:"user_id,force"`
+	MonthCount    int                `tg:"month_count,force"`
+	StarCount     int                `tg:"star_count,force"`
+	Text          string             `tg:"text"`
+	TextParseMode tg.ParseMode       `tg:"text_parse_mode"`
+	TextEntities  []tg.MessageEntity `tg:"text_entities"`
+}
+
+// Method returns the method name.
+func (GiftPremiumSubscription) Method() string { return "giftPremiumSubscription" }
+
+// Do performs the request.
+func (p GiftPremiumSubscription) Do(ctx context.Context, a *api.API) error {
+	_, err := request[api.Empty](ctx, a, p)
+	return err
+}
+
+// HideGeneralForumTopic contains the parameters of the hideGeneralForumTopic method.
+//
+// https://core.telegram.org/bots/api#hidegeneralforumtopic
+type HideGeneralForumTopic struct {
+	ChatID tg.ChatID `tg:"chat_id"`
+}
+
+// Method returns the method name.
+func (HideGeneralForumTopic) Method() string { return "hideGeneralForumTopic" }
+
+// Do performs the request.
+func (p HideGeneralForumTopic) Do(ctx context.Context, a *api.API) error {
+	_, err := request[api.Empty](ctx, a, p)
+	return err
+}
+
+// LeaveChat contains the parameters of the leaveChat method.
+//
+// https://core.telegram.org/bots/api#leavechat
+type LeaveChat struct {
+	ChatID tg.ChatID `tg:"chat_id"`
+}
+
+// Method returns the method name.
+func (LeaveChat) Method() string { return "leaveChat" }
+
+// Do performs the request.
+func (p LeaveChat) Do(ctx context.Context, a *api.API) error {
+	_, err := request[api.Empty](ctx, a, p)
+	return err
+}
+
+// LogOut contains the parameters of the logOut method.
+//
+// https://core.telegram.org/bots/api#logout
+type LogOut struct{}
+
+// Method returns the method name.
+func (LogOut) Method() string { return "logOut" }
+
+// Do performs the request.
+func (p LogOut) Do(ctx context.Context, a *api.API) error {
+	_, err := request[api.Empty](ctx, a, p)
+	return err
+}
+
+// PinChatMessage contains the parameters of the pinChatMessage method.
+//
+// https://core.telegram.org/bots/api#pinchatmessage
+type PinChatMessage struct {
+	BusinessConnectionID string    `tg:"business_connection_id"`
+	ChatID               tg.ChatID `tg:"chat_id"`
+	MessageID            tg.ID     `tg:"message_id,force"`
+	DisableNotification  bool      `tg:"disable_notification"`
+}
+
+// Method returns the method name.
+func (PinChatMessage) Method() string { return "pinChatMessage" }
+
+// Do performs the request.
+func (p PinChatMessage) Do(ctx context.Context, a *api.API) error {
+	_, err := request[api.Empty](ctx, a, p)
+	return err
+}
+
+// PostStory contains the parameters of the postStory method.
+//
+// https://core.telegram.org/bots/api#poststory
+type PostStory struct {
+	BusinessConnectionID string               `tg:"business_connection_id"`
+	Content              tg.InputStoryContent `tg:"content"`
+	ActivePeriod         tg.Duration          `tg:"active_period,force"`
+	Caption              string               `tg:"caption"`
+	ParseMode            tg.ParseMode         `tg:"parse_mode"`
+	CaptionEntities      []tg.MessageEntity   `tg:"caption_entities"`
+	Areas                []tg.StoryArea       `tg:"areas"`
+	PostToChatPage       bool                 `tg:"post_to_chat_page"`
+	ProtectContent       bool                 `tg:"protect_content"`
+}
+
+// Method returns the method name.
+func (PostStory) Method() string { return "postStory" }
+
+// Do performs the request.
+func (p PostStory) Do(ctx context.Context, a *api.API) (*tg.Story, error) {
+	return request[*tg.Story](ctx, a, p)
+}
+
+// PromoteChatMember contains the parameters of the promoteChatMember method.
+//
+// https://core.telegram.org/bots/api#promotechatmember
+type PromoteChatMember struct {
+	ChatID              tg.ChatID `tg:"chat_id"`
+	UserID              tg.ID     `tg:"user_id,force"`
+	IsAnonymous         bool      `tg:"is_anonymous"`
+	CanManageChat       bool      `tg:"can_manage_chat"`
+	CanDeleteMessages   bool      `tg:"can_delete_messages"`
+	CanManageVideoChats bool      `tg:"can_manage_video_chats"`
+	CanRestrictMembers  bool      `tg:"can_restrict_members"`
+	CanPromoteMembers   bool      `tg:"can_promote_members"`
+	CanChangeInfo       bool      `tg:"can_change_info"`
+	CanInviteUsers      bool      `tg:"can_invite_users"`
+	CanPostStories      bool      `tg:"can_post_stories"`
+	CanEditStories      bool      `tg:"can_edit_stories"`
+	CanDeleteStories    bool      `tg:"can_delete_stories"`
+	CanPostMessages     bool      `tg:"can_post_messages"`
+	CanEditMessages     bool      `tg:"can_edit_messages"`
+	CanPinMessages      bool      `tg:"can_pin_messages"`
+	CanManageTopics     bool      `tg:"can_manage_topics"`
+}
+
+// Method returns the method name.
+func (PromoteChatMember) Method() string { return "promoteChatMember" }
+
+// Do performs the request.
+func (p PromoteChatMember) Do(ctx context.Context, a *api.API) error {
+	_, err := request[api.Empty](ctx, a, p)
+	return err
+}
+
+// ReadBusinessMessage contains the parameters of the readBusinessMessage method.
+//
+// https://core.telegram.org/bots/api#readbusinessmessage
+type ReadBusinessMessage struct {
+	BusinessConnectionID string `tg:"business_connection_id"`
+	ChatID               tg.ID  `tg:"chat_id,force"`
+	MessageID            tg.ID  `tg:"message_id,force"`
+}
+
+// Method returns the method name.
+func (ReadBusinessMessage) Method() string { return "readBusinessMessage" }
+
+// Do performs the request.
+func (p ReadBusinessMessage) Do(ctx context.Context, a *api.API) error {
+	_, err := request[api.Empty](ctx, a, p)
+	return err
+}
+
+// RefundStarPayment contains the parameters of the refundStarPayment method.
+//
+// https://core.telegram.org/bots/api#refundstarpayment
+type RefundStarPayment struct {
+	UserID                  tg.ID  `tg:"user_id,force"`
+	TelegramPaymentChargeID string `tg:"telegram_payment_charge_id"`
+}
+
+// Method returns the method name.
+func (RefundStarPayment) Method() string { return "refundStarPayment" }
+
+// Do performs the request.
+func (p RefundStarPayment) Do(ctx context.Context, a *api.API) error {
+	_, err := request[api.Empty](ctx, a, p)
+	return err
+}
+
+// RemoveBusinessAccountProfilePhoto contains the parameters of the removeBusinessAccountProfilePhoto method.
+//
+// https://core.telegram.org/bots/api#removebusinessaccountprofilephoto
+type RemoveBusinessAccountProfilePhoto struct {
+	BusinessConnectionID string `tg:"business_connection_id"`
+	IsPublic             bool   `tg:"is_public"`
+}
+
+// Method returns the method name.
+func (RemoveBusinessAccountProfilePhoto) Method() string { return "removeBusinessAccountProfilePhoto" }
+
+// Do performs the request.
+func (p RemoveBusinessAccountProfilePhoto) Do(ctx context.Context, a *api.API) error {
+	_, err := request[api.Empty](ctx, a, p)
+	return err
+}
+
+// RemoveChatVerification contains the parameters of the removeChatVerification method.
+//
+// https://core.telegram.org/bots/api#removechatverification
+type RemoveChatVerification struct {
+	ChatID tg.ChatID `tg:"chat_id"`
+}
+
+// Method returns the method name.
+func (RemoveChatVerification) Method() string { return "removeChatVerification" }
+
+// Do performs the request.
+func (p RemoveChatVerification) Do(ctx context.Context, a *api.API) error {
+	_, err := request[api.Empty](ctx, a, p)
+	return err
+}
+
+// RemoveUserVerification contains the parameters of the removeUserVerification method.
+//
+// https://core.telegram.org/bots/api#removeuserverification
+type RemoveUserVerification struct {
+	UserID tg.ID `tg:"user_id,force"`
+}
+
+// Method returns the method name.
+func (RemoveUserVerification) Method() string { return "removeUserVerification" }
+
+// Do performs the request.
+func (p RemoveUserVerification) Do(ctx context.Context, a *api.API) error {
+	_, err := request[api.Empty](ctx, a, p)
+	return err
+}
+
+// ReopenForumTopic contains the parameters of the reopenForumTopic method.
+//
+// https://core.telegram.org/bots/api#reopenforumtopic
+type ReopenForumTopic struct {
+	ChatID          tg.ChatID `tg:"chat_id"`
+	MessageThreadID tg.ID     `tg:"message_thread_id,force"`
+}
+
+// Method returns the method name.
+func (ReopenForumTopic) Method() string { return "reopenForumTopic" }
+
+// Do performs the request.
+func (p ReopenForumTopic) Do(ctx context.Context, a *api.API) error {
+	_, err := request[api.Empty](ctx, a, p)
+	return err
+}
+
+// ReopenGeneralForumTopic contains the parameters of the reopenGeneralForumTopic method.
+//
+// https://core.telegram.org/bots/api#reopengeneralforumtopic
+type ReopenGeneralForumTopic struct {
+	ChatID tg.ChatID `tg:"chat_id"`
+}
+
+// Method returns the method name.
+func (ReopenGeneralForumTopic) Method() string { return "reopenGeneralForumTopic" }
+
+// Do performs the request.
+func (p ReopenGeneralForumTopic) Do(ctx context.Context, a *api.API) error {
+	_, err := request[api.Empty](ctx, a, p)
+	return err
+}
+
+// ReplaceStickerInSet contains the parameters of the replaceStickerInSet method.
+//
+// https://core.telegram.org/bots/api#replacestickerinset
+type ReplaceStickerInSet struct {
+	UserID     tg.ID           `tg:"user_id,force"`
+	Name       string          `tg:"name"`
+	OldSticker string          `tg:"old_sticker"`
+	Sticker    tg.InputSticker `tg:"sticker"`
+}
+
+// Method returns the method name.
+func (ReplaceStickerInSet) Method() string { return "replaceStickerInSet" }
+
+// Do performs the request.
+func (p ReplaceStickerInSet) Do(ctx context.Context, a *api.API) error {
+	_, err := request[api.Empty](ctx, a, p)
+	return err
+}
+
+// RestrictChatMember contains the parameters of the restrictChatMember method.
+//
+// https://core.telegram.org/bots/api#restrictchatmember
+type RestrictChatMember struct {
+	ChatID                        tg.ChatID          `tg:"chat_id"`
+	UserID                        tg.ID              `tg:"user_id,force"`
+	Permissions                   tg.ChatPermissions `tg:"permissions"`
+	UseIndependentChatPermissions bool               `tg:"use_independent_chat_permissions"`
+	UntilDate                     tg.Date            `tg:"until_date"`
+}
+
+// Method returns the method name.
+func (RestrictChatMember) Method() string { return "restrictChatMember" }
+
+// Do performs the request.
+func (p RestrictChatMember) Do(ctx context.Context, a *api.API) error {
+	_, err := request[api.Empty](ctx, a, p)
+	return err
+}
+
+// RevokeChatInviteLink contains the parameters of the revokeChatInviteLink method.
+//
+// https://core.telegram.org/bots/api#revokechatinvitelink
+type RevokeChatInviteLink struct {
+	ChatID     tg.ChatID `tg:"chat_id"`
+	InviteLink string    `tg:"invite_link"`
+}
+
+// Method returns the method name.
+func (RevokeChatInviteLink) Method() string { return "revokeChatInviteLink" }
+
+// Do performs the request.
+func (p RevokeChatInviteLink) Do(ctx context.Context, a *api.API) (*tg.ChatInviteLink, error) {
+	return request[*tg.ChatInviteLink](ctx, a, p)
+}
+
+// SavePreparedInlineMessage contains the parameters of the savePreparedInlineMessage method.
+//
+// https://core.telegram.org/bots/api#savepreparedinlinemessage
+type SavePreparedInlineMessage struct {
+	UserID            tg.ID                  `tg:"user_id,force"`
+	Result            tg.InlineQueryResulter `tg:"result"`
+	AllowUserChats    bool                   `tg:"allow_user_chats"`
+	AllowBotChats     bool                   `tg:"allow_bot_chats"`
+	AllowGroupChats   bool                   `tg:"allow_group_chats"`
+	AllowChannelChats bool                   `tg:"allow_channel_chats"`
+}
+
+// Method returns the method name.
+func (SavePreparedInlineMessage) Method() string { return "savePreparedInlineMessage" }
+
+// Do performs the request.
+func (p SavePreparedInlineMessage) Do(ctx context.Context, a *api.API) (*tg.PreparedInlineMessage, error) {
+	return request[*tg.PreparedInlineMessage](ctx, a, p)
+}
+
+// SendAnimation contains the parameters of the sendAnimation method.
+//
+// https://core.telegram.org/bots/api#sendanimation
+type SendAnimation struct {
+	BusinessConnectionID  string              `tg:"business_connection_id"`
+	ChatID                tg.ChatID           `tg:"chat_id"`
+	MessageThreadID       tg.ID               `tg:"message_thread_id"`
+	Animation             tg.Inputtable       `tg:"animation"`
+	Duration              tg.Duration         `tg:"duration"`
+	Width                 int                 `tg:"width"`
+	Height                int                 `tg:"height"`
+	Thumbnail             tg.Inputtable       `tg:"thumbnail"`
+	Caption               string              `tg:"caption"`
+	ParseMode             tg.ParseMode        `tg:"parse_mode"`
+	CaptionEntities       []tg.MessageEntity  `tg:"caption_entities"`
+	ShowCaptionAboveMedia bool                `tg:"show_caption_above_media"`
+	HasSpoiler            bool                `tg:"has_spoiler"`
+	DisableNotification   bool                `tg:"disable_notification"`
+	ProtectContent        bool                `tg:"protect_content"`
+	AllowPaidBroadcast    bool                `tg:"allow_paid_broadcast"`
+	MessageEffectID       string              `tg:"message_effect_id"`
+	ReplyParameters       *tg.ReplyParameters `tg:"reply_parameters"`
+	ReplyMarkup           tg.ReplyMarkup      `tg:"reply_markup"`
+}
+
+// Method returns the method name.
+func (SendAnimation) Method() string { return "sendAnimation" }
+
+// Do performs the request.
+func (p SendAnimation) Do(ctx context.Context, a *api.API) (*tg.Message, error) {
+	return request[*tg.Message](ctx, a, p)
+}
+
+// SendAudio contains the parameters of the sendAudio method.
+//
+// https://core.telegram.org/bots/api#sendaudio
+type SendAudio struct {
+	BusinessConnectionID string              `tg:"business_connection_id"`
+	ChatID               tg.ChatID           `tg:"chat_id"`
+	MessageThreadID      tg.ID               `tg:"message_thread_id"`
+	Audio                tg.Inputtable       `tg:"audio"`
+	Caption              string              `tg:"caption"`
+	ParseMode            tg.ParseMode        `tg:"parse_mode"`
+	CaptionEntities      []tg.MessageEntity  `tg:"caption_entities"`
+	Duration             tg.Duration         `tg:"duration"`
+	Performer            string              `tg:"performer"`
+	Title                string              `tg:"title"`
+	Thumbnail            tg.Inputtable       `tg:"thumbnail"`
+	DisableNotification  bool                `tg:"disable_notification"`
+	ProtectContent       bool                `tg:"protect_content"`
+	AllowPaidBroadcast   bool                `tg:"allow_paid_broadcast"`
+	MessageEffectID      string              `tg:"message_effect_id"`
+	ReplyParameters      *tg.ReplyParameters `tg:"reply_parameters"`
+	ReplyMarkup          tg.ReplyMarkup      `tg:"reply_markup"`
+}
+
+// Method returns the method name.
+func (SendAudio) Method() string { return "sendAudio" }
+
+// Do performs the request.
+func (p SendAudio) Do(ctx context.Context, a *api.API) (*tg.Message, error) {
+	return request[*tg.Message](ctx, a, p)
+}
+
+// SendChatAction contains the parameters of the sendChatAction method.
+//
+// https://core.telegram.org/bots/api#sendchataction
+type SendChatAction struct {
+	BusinessConnectionID string    `tg:"business_connection_id"`
+	ChatID               tg.ChatID `tg:"chat_id"`
+	MessageThreadID      tg.ID     `tg:"message_thread_id"`
+	Action               string    `tg:"action"`
+}
+
+// Method returns the method name.
+func (SendChatAction) Method() string { return "sendChatAction" }
+
+// Do performs the request.
+func (p SendChatAction) Do(ctx context.Context, a *api.API) error {
+	_, err := request[api.Empty](ctx, a, p)
+	return err
+}
+
+// SendChecklist contains the parameters of the sendChecklist method.
+//
+// https://core.telegram.org/bots/api#sendchecklist
+type SendChecklist struct {
+	BusinessConnectionID string                   `tg:"business_connection_id"`
+	ChatID               tg.ID                    `tg:"chat_id,force"`
+	Checklist            tg.InputChecklist        `tg:"checklist"`
+	DisableNotification  bool                     `tg:"disable_notification"`
+	ProtectContent       bool                     `tg:"protect_content"`
+	MessageEffectID      string                   `tg:"message_effect_id"`
+	ReplyParameters      *tg.ReplyParameters      `tg:"reply_parameters"`
+	ReplyMarkup          *tg.InlineKeyboardMarkup `tg:"reply_markup"`
+}
+
+// Method returns the method name.
+func (SendChecklist) Method() string { return "sendChecklist" }
+
+// Do performs the request.
+func (p SendChecklist) Do(ctx context.Context, a *api.API) (*tg.Message, error) {
+	return request[*tg.Message](ctx, a, p)
+}
+
+// SendContact contains the parameters of the sendContact method.
+//
+// https://core.telegram.org/bots/api#sendcontact
+type SendContact struct {
+	BusinessConnectionID string              `tg:"business_connection_id"`
+	ChatID               tg.ChatID           `tg:"chat_id"`
+	MessageThreadID      tg.ID               `tg:"message_thread_id"`
+	PhoneNumber          string              `tg:"phone_number"`
+	FirstName            string              `tg:"first_name"`
+	LastName             string              `tg:"last_name"`
+	Vcard                string              `tg:"vcard"`
+	DisableNotification  bool                `tg:"disable_notification"`
+	ProtectContent       bool                `tg:"protect_content"`
+	AllowPaidBroadcast   bool                `tg:"allow_paid_broadcast"`
+	MessageEffectID      string              `tg:"message_effect_id"`
+	ReplyParameters      *tg.ReplyParameters `tg:"reply_parameters"`
+	ReplyMarkup          tg.ReplyMarkup      `tg:"reply_markup"`
+}
+
+// Method returns the method name.
+func (SendContact) Method() string { return "sendContact" }
+
+// Do performs the request.
+func (p SendContact) Do(ctx context.Context, a *api.API) (*tg.Message, error) {
+	return request[*tg.Message](ctx, a, p)
+}
+
+// SendDice contains the parameters of the sendDice method.
+//
+// https://core.telegram.org/bots/api#senddice
+type SendDice struct {
+	BusinessConnectionID string              `tg:"business_connection_id"`
+	ChatID               tg.ChatID           `tg:"chat_id"`
+	MessageThreadID      tg.ID               `tg:"message_thread_id"`
+	Emoji                string              `tg:"emoji"`
+	DisableNotification  bool                `tg:"disable_notification"`
+	ProtectContent       bool                `tg:"protect_content"`
+	AllowPaidBroadcast   bool                `tg:"allow_paid_broadcast"`
+	MessageEffectID      string              `tg:"message_effect_id"`
+	ReplyParameters      *tg.ReplyParameters `tg:"reply_parameters"`
+	ReplyMarkup          tg.ReplyMarkup      `tg:"reply_markup"`
+}
+
+// Method returns the method name.
+func (SendDice) Method() string { return "sendDice" }
+
+// Do performs the request.
+func (p SendDice) Do(ctx context.Context, a *api.API) (*tg.Message, error) {
+	return request[*tg.Message](ctx, a, p)
+}
+
+// SendDocument contains the parameters of the sendDocument method.
+//
+// https://core.telegram.org/bots/api#senddocument
+type SendDocument struct {
+	BusinessConnectionID        string              `tg:"business_connection_id"`
+	ChatID                      tg.ChatID           `tg:"chat_id"`
+	MessageThreadID             tg.ID               `tg:"message_thread_id"`
+	Document                    tg.Inputtable       `tg:"document"`
+	Thumbnail                   tg.Inputtable       `tg:"thumbnail"`
+	Caption                     string              `tg:"caption"`
+	ParseMode                   tg.ParseMode        `tg:"parse_mode"`
+	CaptionEntities             []tg.MessageEntity  `tg:"caption_entities"`
+	DisableContentTypeDetection bool                `tg:"disable_content_type_detection"`
+	DisableNotification         bool                `tg:"disable_notification"`
+	ProtectContent              bool                `tg:"protect_content"`
+	AllowPaidBroadcast          bool                `tg:"allow_paid_broadcast"`
+	MessageEffectID             string              `tg:"message_effect_id"`
+	ReplyParameters             *tg.ReplyParameters `tg:"reply_parameters"`
+	ReplyMarkup                 tg.ReplyMarkup      `tg:"reply_markup"`
+}
+
+// Method returns the method name.
+func (SendDocument) Method() string { return "sendDocument" }
+
+// Do performs the request.
+func (p SendDocument) Do(ctx context.Context, a *api.API) (*tg.Message, error) {
+	return request[*tg.Message](ctx, a, p)
+}
+
+// SendGame contains the parameters of the sendGame method.
+//
+// https://core.telegram.org/bots/api#sendgame
+type SendGame struct {
+	BusinessConnectionID string                   `tg:"business_connection_id"`
+	ChatID               tg.ID                    `tg:"chat_id,force"`
+	MessageThreadID      tg.ID                    `tg:"message_thread_id"`
+	GameShortName        string                   `tg:"game_short_name"`
+	DisableNotification  bool                     `tg:"disable_notification"`
+	ProtectContent       bool                     `tg:"protect_content"`
+	AllowPaidBroadcast   bool                     `tg:"allow_paid_broadcast"`
+	MessageEffectID      string                   `tg:"message_effect_id"`
+	ReplyParameters      *tg.ReplyParameters      `tg:"reply_parameters"`
+	ReplyMarkup          *tg.InlineKeyboardMarkup `tg:"reply_markup"`
+}
+
+// Method returns the method name.
+func (SendGame) Method() string { return "sendGame" }
+
+// Do performs the request.
+func (p SendGame) Do(ctx context.Context, a *api.API) (*tg.Message, error) {
+	return request[*tg.Message](ctx, a, p)
+}
+
+// SendGift contains the parameters of the sendGift method.
+//
+// https://core.telegram.org/bots/api#sendgift
+type SendGift struct {
+	UserID        tg.ID              `tg:"user_id"`
+	ChatID        tg.ChatID          `tg:"chat_id"`
+	GiftID        string             `tg:"gift_id"`
+	PayForUpgrade bool               `tg:"pay_for_upgrade"`
+	Text          string             `tg:"text"`
+	TextParseMode tg.ParseMode       `tg:"text_parse_mode"`
+	TextEntities  []tg.MessageEntity `tg:"text_entities"`
+}
+
+// Method returns the method name.
+func (SendGift) Method() string { return "sendGift" }
+
+// Do performs the request.
+func (p SendGift) Do(ctx context.Context, a *api.API) error {
+	_, err := request[api.Empty](ctx, a, p)
+	return err
+}
+
+// SendInvoice contains the parameters of the sendInvoice method.
+//
+// https://core.telegram.org/bots/api#sendinvoice
+type SendInvoice struct {
+	ChatID                    tg.ChatID                `tg:"chat_id"`
+	MessageThreadID           tg.ID                    `tg:"message_thread_id"`
+	Title                     string                   `tg:"title"`
+	Description               string                   `tg:"description"`
+	Payload                   string                   `tg:"payload"`
+	ProviderToken             string                   `tg:"provider_token"`
+	Currency                  string                   `tg:"currency"`
+	Prices                    []tg.LabeledPrice        `tg:"prices"`
+	MaxTipAmount              int                      `tg:"max_tip_amount"`
+	SuggestedTipAmounts       []int                    `tg:"suggested_tip_amounts"`
+	StartParameter            string                   `tg:"start_parameter"`
+	ProviderData              string                   `tg:"provider_data"`
+	PhotoURL                  string                   `tg:"photo_url"`
+	PhotoSize                 int                      `tg:"photo_size"`
+	PhotoWidth                int                      `tg:"photo_width"`
+	PhotoHeight               int                      `tg:"photo_height"`
+	NeedName                  bool                     `tg:"need_name"`
+	NeedPhoneNumber           bool                     `tg:"need_phone_number"`
+	NeedEmail                 bool                     `tg:"need_email"`
+	NeedShippingAddress       bool                     `tg:"need_shipping_address"`
+	SendPhoneNumberToProvider bool                     `tg:"send_phone_number_to_provider"`
+	SendEmailToProvider       bool                     `tg:"send_email_to_provider"`
+	IsFlexible                bool                     `tg:"is_flexible"`
+	DisableNotification       bool                     `tg:"disable_notification"`
+	ProtectContent            bool                     `tg:"protect_content"`
+	AllowPaidBroadcast        bool                     `tg:"allow_paid_broadcast"`
+	MessageEffectID           string                   `tg:"message_effect_id"`
+	ReplyParameters           *tg.ReplyParameters      `tg:"reply_parameters"`
+	ReplyMarkup               *tg.InlineKeyboardMarkup `tg:"reply_markup"`
+}
+
+// Method returns the method name.
+func (SendInvoice) Method() string { return "sendInvoice" }
+
+// Do performs the request.
+func (p SendInvoice) Do(ctx context.Context, a *api.API) (*tg.Message, error) {
+	return request[*tg.Message](ctx, a, p)
+}
+
+// SendLocation contains the parameters of the sendLocation method.
+//
+// https://core.telegram.org/bots/api#sendlocation
+type SendLocation struct {
+	BusinessConnectionID string              `tg:"business_connection_id"`
+	ChatID               tg.ChatID           `tg:"chat_id"`
+	MessageThreadID      tg.ID               `tg:"message_thread_id"`
+	Latitude             float64             `tg:"latitude,force"`
+	Longitude            float64             `tg:"longitude,force"`
+	HorizontalAccuracy   float64             `tg:"horizontal_accuracy"`
+	LivePeriod           tg.Duration         `tg:"live_period"`
+	Heading              int                 `tg:"heading"`
+	ProximityAlertRadius int                 `tg:"proximity_alert_radius"`
+	DisableNotification  bool                `tg:"disable_notification"`
+	ProtectContent       bool                `tg:"protect_content"`
+	AllowPaidBroadcast   bool                `tg:"allow_paid_broadcast"`
+	MessageEffectID      string              `tg:"message_effect_id"`
+	ReplyParameters      *tg.ReplyParameters `tg:"reply_parameters"`
+	ReplyMarkup          tg.ReplyMarkup      `tg:"reply_markup"`
+}
+
+// Method returns the method name.
+func (SendLocation) Method() string { return "sendLocation" }
+
+// Do performs the request.
+func (p SendLocation) Do(ctx context.Context, a *api.API) (*tg.Message, error) {
+	return request[*tg.Message](ctx, a, p)
+}
+
+// SendMediaGroup contains the parameters of the sendMediaGroup method.
+//
+// https://core.telegram.org/bots/api#sendmediagroup
+type SendMediaGroup struct {
+	BusinessConnectionID string              `tg:"business_connection_id"`
+	ChatID               tg.ChatID           `tg:"chat_id"`
+	MessageThreadID      tg.ID               `tg:"message_thread_id"`
+	Media                []tg.InputMedia     `tg:"media"`
+	DisableNotification  bool                `tg:"disable_notification"`
+	ProtectContent       bool                `tg:"protect_content"`
+	AllowPaidBroadcast   bool                `tg:"allow_paid_broadcast"`
+	MessageEffectID      string              `tg:"message_effect_id"`
+	ReplyParameters      *tg.ReplyParameters `tg:"reply_parameters"`
+}
+
+// Method returns the method name.
+func (SendMediaGroup) Method() string { return "sendMediaGroup" }
+
+// Do performs the request.
+func (p SendMediaGroup) Do(ctx context.Context, a *api.API) ([]tg.Message, error) {
+	return request[[]tg.Message](ctx, a, p)
+}
+
+// SendMessage contains the parameters of the sendMessage method.
+//
+// https://core.telegram.org/bots/api#sendmessage
+type SendMessage struct {
+	BusinessConnectionID string                 `tg:"business_connection_id"`
+	ChatID               tg.ChatID              `tg:"chat_id"`
+	MessageThreadID      tg.ID                  `tg:"message_thread_id"`
+	Text                 string                 `tg:"text"`
+	ParseMode            tg.ParseMode           `tg:"parse_mode"`
+	Entities             []tg.MessageEntity     `tg:"entities"`
+	LinkPreviewOptions   *tg.LinkPreviewOptions `tg:"link_preview_options"`
+	DisableNotification  bool                   `tg:"disable_notification"`
+	ProtectContent       bool                   `tg:"protect_content"`
+	AllowPaidBroadcast   bool                   `tg:"allow_paid_broadcast"`
+	MessageEffectID      string                 `tg:"message_effect_id"`
+	ReplyParameters      *tg.ReplyParameters    `tg:"reply_parameters"`
+	ReplyMarkup          tg.ReplyMarkup         `tg:"reply_markup"`
+}
+
+// Method returns the method name.
+func (SendMessage) Method() string { return "sendMessage" }
+
+// Do performs the request.
+func (p SendMessage) Do(ctx context.Context, a *api.API) (*tg.Message, error) {
+	return request[*tg.Message](ctx, a, p)
+}
+
+// SendPaidMedia contains the parameters of the sendPaidMedia method.
+//
+// https://core.telegram.org/bots/api#sendpaidmedia
+type SendPaidMedia struct {
+	BusinessConnectionID  string              `tg:"business_connection_id"`
+	ChatID                tg.ChatID           `tg:"chat_id"`
+	StarCount             int                 `tg:"star_count,force"`
+	Media                 []tg.InputPaidMedia `tg:"media"`
+	Payload               string              `tg:"payload"`
+	Caption               string              `tg:"caption"`
+	ParseMode             tg.ParseMode        `tg:"parse_mode"`
+	CaptionEntities       []tg.MessageEntity  `tg:"caption_entities"`
+	ShowCaptionAboveMedia bool                `tg:"show_caption_above_media"`
+	DisableNotification   bool                `tg:"disable_notification"`
+	ProtectContent        bool                `tg:"protect_content"`
+	AllowPaidBroadcast    bool                `tg:"allow_paid_broadcast"`
+	ReplyParameters       *tg.ReplyParameters `tg:"reply_parameters"`
+	ReplyMarkup           tg.ReplyMarkup      `tg:"reply_markup"`
+}
+
+// Method returns the method name.
+func (SendPaidMedia) Method() string { return "sendPaidMedia" }
+
+// Do performs the request.
+func (p SendPaidMedia) Do(ctx context.Context, a *api.API) (*tg.Message, error) {
+	return request[*tg.Message](ctx, a, p)
+}
+
+// SendPhoto contains the parameters of the sendPhoto method.
+//
+// https://core.telegram.org/bots/api#sendphoto
+type SendPhoto struct {
+	BusinessConnectionID  string              `tg:"business_connection_id"`
+	ChatID                tg.ChatID           `tg:"chat_id"`
+	MessageThreadID       tg.ID               `tg:"message_thread_id"`
+	Photo                 tg.Inputtable       `tg:"photo"`
+	Caption               string              `tg:"caption"`
+	ParseMode             tg.ParseMode        `tg:"parse_mode"`
+	CaptionEntities       []tg.MessageEntity  `tg:"caption_entities"`
+	ShowCaptionAboveMedia bool                `tg:"show_caption_above_media"`
+	HasSpoiler            bool                `tg:"has_spoiler"`
+	DisableNotification   bool                `tg:"disable_notification"`
+	ProtectContent        bool                `tg:"protect_content"`
+	AllowPaidBroadcast    bool                `tg:"allow_paid_broadcast"`
+	MessageEffectID       string              `tg:"message_effect_id"`
+	ReplyParameters       *tg.ReplyParameters `tg:"reply_parameters"`
+	ReplyMarkup           tg.ReplyMarkup      `tg:"reply_markup"`
+}
+
+// Method returns the method name.
+func (SendPhoto) Method() string { return "sendPhoto" }
+
+// Do performs the request.
+func (p SendPhoto) Do(ctx context.Context, a *api.API) (*tg.Message, error) {
+	return request[*tg.Message](ctx, a, p)
+}
+
+// SendPoll contains the parameters of the sendPoll method.
+//
+// https://core.telegram.org/bots/api#sendpoll
+type SendPoll struct {
+	BusinessConnectionID  string               `tg:"business_connection_id"`
+	ChatID                tg.ChatID            `tg:"chat_id"`
+	MessageThreadID       tg.ID                `tg:"message_thread_id"`
+	Question              string               `tg:"question"`
+	QuestionParseMode     tg.ParseMode         `tg:"question_parse_mode"`
+	QuestionEntities      []tg.MessageEntity   `tg:"question_entities"`
+	Options               []tg.InputPollOption `tg:"options"`
+	IsAnonymous           bool                 `tg:"is_anonymous"`
+	Type                  string               `tg:"type"`
+	AllowsMultipleAnswers bool                 `tg:"allows_multiple_answers"`
+	CorrectOptionID       tg.ID                `tg:"correct_option_id"`
+	Explanation           string               `tg:"explanation"`
+	ExplanationParseMode  tg.ParseMode         `tg:"explanation_parse_mode"`
+	ExplanationEntities   []tg.MessageEntity   `tg:"explanation_entities"`
+	OpenPeriod            tg.Duration          `tg:"open_period"`
+	CloseDate             tg.Date              `tg:"close_date"`
+	IsClosed              bool                 `tg:"is_closed"`
+	DisableNotification   bool                 `tg:"disable_notification"`
+	ProtectContent        bool                 `tg:"protect_content"`
+	AllowPaidBroadcast    bool                 `tg:"allow_paid_broadcast"`
+	MessageEffectID       string               `tg:"message_effect_id"`
+	ReplyParameters       *tg.ReplyParameters  `tg:"reply_parameters"`
+	ReplyMarkup           tg.ReplyMarkup       `tg:"reply_markup"`
+}
+
+// Method returns the method name.
+func (SendPoll) Method() string { return "sendPoll" }
+
+// Do performs the request.
+func (p SendPoll) Do(ctx context.Context, a *api.API) (*tg.Message, error) {
+	return request[*tg.Message](ctx, a, p)
+}
+
+// SendSticker contains the parameters of the sendSticker method.
+//
+// https://core.telegram.org/bots/api#sendsticker
+type SendSticker struct {
+	BusinessConnectionID string              `tg:"business_connection_id"`
+	ChatID               tg.ChatID           `tg:"chat_id"`
+	MessageThreadID      tg.ID               `tg:"message_thread_id"`
+	Sticker              tg.Inputtable       `tg:"sticker"`
+	Emoji                string              `tg:"emoji"`
+	DisableNotification  bool                `tg:"disable_notification"`
+	ProtectContent       bool                `tg:"protect_content"`
+	AllowPaidBroadcast   bool                `tg:"allow_paid_broadcast"`
+	MessageEffectID      string              `tg:"message_effect_id"`
+	ReplyParameters      *tg.ReplyParameters `tg:"reply_parameters"`
+	ReplyMarkup          tg.ReplyMarkup      `tg:"reply_markup"`
+}
+
+// Method returns the method name.
+func (SendSticker) Method() string { return "sendSticker" }
+
+// Do performs the request.
+func (p SendSticker) Do(ctx context.Context, a *api.API) (*tg.Message, error) {
+	return request[*tg.Message](ctx, a, p)
+}
+
+// SendVenue contains the parameters of the sendVenue method.
+//
+// https://core.telegram.org/bots/api#sendvenue
+type SendVenue struct {
+	BusinessConnectionID string              `tg:"business_connection_id"`
+	ChatID               tg.ChatID           `tg:"chat_id"`
+	MessageThreadID      tg.ID               `tg:"message_thread_id"`
+	Latitude             float64             `tg:"latitude,force"`
+	Longitude            float64             `tg:"longitude,force"`
+	Title                string              `tg:"title"`
+	Address              string              `tg:"address"`
+	FoursquareID         string              `tg:"foursquare_id"`
+	FoursquareType       string              `tg:"foursquare_type"`
+	GooglePlaceID        string              `tg:"google_place_id"`
+	GooglePlaceType      string              `tg:"google_place_type"`
+	DisableNotification  bool                `tg:"disable_notification"`
+	ProtectContent       bool                `tg:"protect_content"`
+	AllowPaidBroadcast   bool                `tg:"allow_paid_broadcast"`
+	MessageEffectID      string              `tg:"message_effect_id"`
+	ReplyParameters      *tg.ReplyParameters `tg:"reply_parameters"`
+	ReplyMarkup          tg.ReplyMarkup      `tg:"reply_markup"`
+}
+
+// Method returns the method name.
+func (SendVenue) Method() string { return "sendVenue" }
+
+// Do performs the request.
+func (p SendVenue) Do(ctx context.Context, a *api.API) (*tg.Message, error) {
+	return request[*tg.Message](ctx, a, p)
+}
+
+// SendVideo contains the parameters of the sendVideo method.
+//
+// https://core.telegram.org/bots/api#sendvideo
+type SendVideo struct {
+	BusinessConnectionID  string              `tg:"business_connection_id"`
+	ChatID                tg.ChatID           `tg:"chat_id"`
+	MessageThreadID       tg.ID               `tg:"message_thread_id"`
+	Video                 tg.Inputtable       `tg:"video"`
+	Duration              tg.Duration         `tg:"duration"`
+	Width                 int                 `tg:"width"`
+	Height                int                 `tg:"height"`
+	Thumbnail             tg.Inputtable       `tg:"thumbnail"`
+	Cover                 tg.Inputtable       `tg:"cover"`
+	StartTimestamp        int                 `tg:"start_timestamp"`
+	Caption               string              `tg:"caption"`
+	ParseMode             tg.ParseMode        `tg:"parse_mode"`
+	CaptionEntities       []tg.MessageEntity  `tg:"caption_entities"`
+	ShowCaptionAboveMedia bool                `tg:"show_caption_above_media"`
+	HasSpoiler            bool                `tg:"has_spoiler"`
+	SupportsStreaming     bool                `tg:"supports_streaming"`
+	DisableNotification   bool                `tg:"disable_notification"`
+	ProtectContent        bool                `tg:"protect_content"`
+	AllowPaidBroadcast    bool                `tg:"allow_paid_broadcast"`
+	MessageEffectID       string              `tg:"message_effect_id"`
+	ReplyParameters       *tg.ReplyParameters `tg:"reply_parameters"`
+	ReplyMarkup           tg.ReplyMarkup      `tg:"reply_markup"`
+}
+
+// Method returns the method name.
+func (SendVideo) Method() string { return "sendVideo" }
+
+// Do performs the request.
+func (p SendVideo) Do(ctx context.Context, a *api.API) (*tg.Message, error) {
+	return request[*tg.Message](ctx, a, p)
+}
+
+// SendVideoNote contains the parameters of the sendVideoNote method.
+//
+// https://core.telegram.org/bots/api#sendvideonote
+type SendVideoNote struct {
+	BusinessConnectionID string              `tg:"business_connection_id"`
+	ChatID               tg.ChatID           `tg:"chat_id"`
+	MessageThreadID      tg.ID               `tg:"message_thread_id"`
+	VideoNote            tg.Inputtable       `tg:"video_note"`
+	Duration             tg.Duration         `tg:"duration"`
+	Length               int                 `tg:"length"`
+	Thumbnail            tg.Inputtable       `tg:"thumbnail"`
+	DisableNotification  bool                `tg:"disable_notification"`
+	ProtectContent       bool                `tg:"protect_content"`
+	AllowPaidBroadcast   bool                `tg:"allow_paid_broadcast"`
+	MessageEffectID      string              `tg:"message_effect_id"`
+	ReplyParameters      *tg.ReplyParameters `tg:"reply_parameters"`
+	ReplyMarkup          tg.ReplyMarkup      `tg:"reply_markup"`
+}
+
+// Method returns the method name.
+func (SendVideoNote) Method() string { return "sendVideoNote" }
+
+// Do performs the request.
+func (p SendVideoNote) Do(ctx context.Context, a *api.API) (*tg.Message, error) {
+	return request[*tg.Message](ctx, a, p)
+}
+
+// SendVoice contains the parameters of the sendVoice method.
+//
+// https://core.telegram.org/bots/api#sendvoice
+type SendVoice struct {
+	BusinessConnectionID string              `tg:"business_connection_id"`
+	ChatID               tg.ChatID           `tg:"chat_id"`
+	MessageThreadID      tg.ID               `tg:"message_thread_id"`
+	Voice                tg.Inputtable       `tg:"voice"`
+	Caption              string              `tg:"caption"`
+	ParseMode            tg.ParseMode        `tg:"parse_mode"`
+	CaptionEntities      []tg.MessageEntity  `tg:"caption_entities"`
+	Duration             tg.Duration         `tg:"duration"`
+	DisableNotification  bool                `tg:"disable_notification"`
+	ProtectContent       bool                `tg:"protect_content"`
+	AllowPaidBroadcast   bool                `tg:"allow_paid_broadcast"`
+	MessageEffectID      string              `tg:"message_effect_id"`
+	ReplyParameters      *tg.ReplyParameters `tg:"reply_parameters"`
+	ReplyMarkup          tg.ReplyMarkup      `tg:"reply_markup"`
+}
+
+// Method returns the method name.
+func (SendVoice) Method() string { return "sendVoice" }
+
+// Do performs the request.
+func (p SendVoice) Do(ctx context.Context, a *api.API) (*tg.Message, error) {
+	return request[*tg.Message](ctx, a, p)
+}
+
+// SetBusinessAccountBio contains the parameters of the setBusinessAccountBio method.
+//
+// https://core.telegram.org/bots/api#setbusinessaccountbio
+type SetBusinessAccountBio struct {
+	BusinessConnectionID string `tg:"business_connection_id"`
+	Bio                  string `tg:"bio"`
+}
+
+// Method returns the method name.
+func (SetBusinessAccountBio) Method() string { return "setBusinessAccountBio" }
+
+// Do performs the request.
+func (p SetBusinessAccountBio) Do(ctx context.Context, a *api.API) error {
+	_, err := request[api.Empty](ctx, a, p)
+	return err
+}
+
+// SetBusinessAccountGiftSettings contains the parameters of the setBusinessAccountGiftSettings method.
+//
+// https://core.telegram.org/bots/api#setbusinessaccountgiftsettings
+type SetBusinessAccountGiftSettings struct {
+	BusinessConnectionID string               `tg:"business_connection_id"`
+	ShowGiftButton       bool                 `tg:"show_gift_button,force"`
+	AcceptedGiftTypes    tg.AcceptedGiftTypes `tg:"accepted_gift_types"`
+}
+
+// Method returns the method name.
+func (SetBusinessAccountGiftSettings) Method() string { return "setBusinessAccountGiftSettings" }
+
+// Do performs the request.
+func (p SetBusinessAccountGiftSettings) Do(ctx context.Context, a *api.API) error {
+	_, err := request[api.Empty](ctx, a, p)
+	return err
+}
+
+// SetBusinessAccountName contains the parameters of the setBusinessAccountName method.
+//
+// https://core.telegram.org/bots/api#setbusinessaccountname
+type SetBusinessAccountName struct {
+	BusinessConnectionID string `tg:"business_connection_id"`
+	FirstName            string `tg:"first_name"`
+	LastName             string `tg:"last_name"`
+}
+
+// Method returns the method name.
+func (SetBusinessAccountName) Method() string { return "setBusinessAccountName" }
+
+// Do performs the request.
+func (p SetBusinessAccountName) Do(ctx context.Context, a *api.API) error {
+	_, err := request[api.Empty](ctx, a, p)
+	return err
+}
+
+// SetBusinessAccountProfilePhoto contains the parameters of the setBusinessAccountProfilePhoto method.
+//
+// https://core.telegram.org/bots/api#setbusinessaccountprofilephoto
+type SetBusinessAccountProfilePhoto struct {
+	BusinessConnectionID string               `tg:"business_connection_id"`
+	Photo                tg.InputProfilePhoto `tg:"photo"`
+	IsPublic             bool                 `tg:"is_public"`
+}
+
+// Method returns the method name.
+func (SetBusinessAccountProfilePhoto) Method() string { return "setBusinessAccountProfilePhoto" }
+
+// Do performs the request.
+func (p SetBusinessAccountProfilePhoto) Do(ctx context.Context, a *api.API) error {
+	_, err := request[api.Empty](ctx, a, p)
+	return err
+}
+
+// SetBusinessAccountUsername contains the parameters of the setBusinessAccountUsername method.
+//
+// https://core.telegram.org/bots/api#setbusinessaccountusername
+type SetBusinessAccountUsername struct {
+	BusinessConnectionID string `tg:"business_connection_id"`
+	Username             string `tg:"username"`
+}
+
+// Method returns the method name.
+func (SetBusinessAccountUsername) Method() string { return "setBusinessAccountUsername" }
+
+// Do performs the request.
+func (p SetBusinessAccountUsername) Do(ctx context.Context, a *api.API) error {
+	_, err := request[api.Empty](ctx, a, p)
+	return err
+}
+
+// SetChatAdministratorCustomTitle contains the parameters of the setChatAdministratorCustomTitle method.
+//
+// https://core.telegram.org/bots/api#setchatadministratorcustomtitle
+type SetChatAdministratorCustomTitle struct {
+	ChatID      tg.ChatID `tg:"chat_id"`
+	UserID      tg.ID     `tg:"user_id,force"`
+	CustomTitle string    `tg:"custom_title"`
+}
+
+// Method returns the method name.
+func (SetChatAdministratorCustomTitle) Method() string { return "setChatAdministratorCustomTitle" }
+
+// Do performs the request.
+func (p SetChatAdministratorCustomTitle) Do(ctx context.Context, a *api.API) error {
+	_, err := request[api.Empty](ctx, a, p)
+	return err
+}
+
+// SetChatDescription contains the parameters of the setChatDescription method.
+//
+// https://core.telegram.org/bots/api#setchatdescription
+type SetChatDescription struct {
+	ChatID      tg.ChatID `tg:"chat_id"`
+	Description string    `tg:"description"`
+}
+
+// Method returns the method name.
+func (SetChatDescription) Method() string { return "setChatDescription" }
+
+// Do performs the request.
+func (p SetChatDescription) Do(ctx context.Context, a *api.API) error {
+	_, err := request[api.Empty](ctx, a, p)
+	return err
+}
+
+// SetChatMenuButton contains the parameters of the setChatMenuButton method.
+//
+// https://core.telegram.org/bots/api#setchatmenubutton
+type SetChatMenuButton struct {
+	ChatID     tg.ID          `tg:"chat_id"`
+	MenuButton *tg.MenuButton `tg:"menu_button"`
+}
+
+// Method returns the method name.
+func (SetChatMenuButton) Method() string { return "setChatMenuButton" }
+
+// Do performs the request.
+func (p SetChatMenuButton) Do(ctx context.Context, a *api.API) error {
+	_, err := request[api.Empty](ctx, a, p)
+	return err
+}
+
+// SetChatPermissions contains the parameters of the setChatPermissions method.
+//
+// https://core.telegram.org/bots/api#setchatpermissions
+type SetChatPermissions struct {
+	ChatID                        tg.ChatID          `tg:"chat_id"`
+	Permissions                   tg.ChatPermissions `tg:"permissions"`
+	UseIndependentChatPermissions bool               `tg:"use_independent_chat_permissions"`
+}
+
+// Method returns the method name.
+func (SetChatPermissions) Method() string { return "setChatPermissions" }
+
+// Do performs the request.
+func (p SetChatPermissions) Do(ctx context.Context, a *api.API) error {
+	_, err := request[api.Empty](ctx, a, p)
+	return err
+}
+
+// SetChatPhoto contains the parameters of the setChatPhoto method.
+//
+// https://core.telegram.org/bots/api#setchatphoto
+type SetChatPhoto struct {
+	ChatID tg.ChatID     `tg:"chat_id"`
+	Photo  tg.Inputtable `tg:"photo"`
+}
+
+// Method returns the method name.
+func (SetChatPhoto) Method() string { return "setChatPhoto" }
+
+// Do performs the request.
+func (p SetChatPhoto) Do(ctx context.Context, a *api.API) error {
+	_, err := request[api.Empty](ctx, a, p)
+	return err
+}
+
+// SetChatStickerSet contains the parameters of the setChatStickerSet method.
+//
+// https://core.telegram.org/bots/api#setchatstickerset
+type SetChatStickerSet struct {
+	ChatID         tg.ChatID `tg:"chat_id"`
+	StickerSetName string    `tg:"sticker_set_name"`
+}
+
+// Method returns the method name.
+func (SetChatStickerSet) Method() string { return "setChatStickerSet" }
+
+// Do performs the request.
+func (p SetChatStickerSet) Do(ctx context.Context, a *api.API) error {
+	_, err := request[api.Empty](ctx, a, p)
+	return err
+}
+
+// SetChatTitle contains the parameters of the setChatTitle method.
+//
+// https://core.telegram.org/bots/api#setchattitle
+type SetChatTitle struct {
+	ChatID tg.ChatID `tg:"chat_id"`
+	Title  string    `tg:"title"`
+}
+
+// Method returns the method name.
+func (SetChatTitle) Method() string { return "setChatTitle" }
+
+// Do performs the request.
+func (p SetChatTitle) Do(ctx context.Context, a *api.API) error {
+	_, err := request[api.Empty](ctx, a, p)
+	return err
+}
+
+// SetCustomEmojiStickerSetThumbnail contains the parameters of the setCustomEmojiStickerSetThumbnail method.
+//
+// https://core.telegram.org/bots/api#setcustomemojistickersetthumbnail
+type SetCustomEmojiStickerSetThumbnail struct {
+	Name          string `tg:"name"`
+	CustomEmojiID string `tg:"custom_emoji_id"`
+}
+
+// Method returns the method name.
+func (SetCustomEmojiStickerSetThumbnail) Method() string { return "setCustomEmojiStickerSetThumbnail" }
+
+// Do performs the request.
+func (p SetCustomEmojiStickerSetThumbnail) Do(ctx context.Context, a *api.API) error {
+	_, err := request[api.Empty](ctx, a, p)
+	return err
+}
+
+// SetGameScore contains the parameters of the setGameScore method.
+//
+// https://core.telegram.org/bots/api#setgamescore
+type SetGameScore struct {
+	UserID             tg.ID  `tg:"user_id,force"`
+	Score              int    `tg:"score,force"`
+	Force              bool   `tg:"force"`
+	DisableEditMessage bool   `tg:"disable_edit_message"`
+	ChatID             tg.ID  `tg:"chat_id"`
+	MessageID          tg.ID  `tg:"message_id"`
+	InlineMessageID    string `tg:"inline_message_id"`
+}
+
+// Method returns the method name.
+func (SetGameScore) Method() string { return "setGameScore" }
+
+// Do performs the request.
+// If the message is inline, the result is nil.
+func (p SetGameScore) Do(ctx context.Context, a *api.API) (*tg.Message, error) {
+	if p.InlineMessageID != "" {
+		_, err := request[api.Empty](ctx, a, p)
+		return nil, err
+	}
+	return request[*tg.Message](ctx, a, p)
+}
+
+// SetMessageReaction contains the parameters of the setMessageReaction method.
+//
+// https://core.telegram.org/bots/api#setmessagereaction
+type SetMessageReaction struct {
+	ChatID    tg.ChatID         `tg:"chat_id"`
+	MessageID tg.ID             `tg:"message_id,force"`
+	Reaction  []tg.ReactionType `tg:"reaction"`
+	IsBig     bool              `tg:"is_big"`
+}
+
+// Method returns the method name.
+func (SetMessageReaction) Method() string { return "setMessageReaction" }
+
+// Do performs the request.
+func (p SetMessageReaction) Do(ctx context.Context, a *api.API) error {
+	_, err := request[api.Empty](ctx, a, p)
+	return err
+}
+
+// SetMyCommands contains the parameters of the setMyCommands method.
+//
+// https://core.telegram.org/bots/api#setmycommands
+type SetMyCommands struct {
+	Commands     []tg.Command     `tg:"commands"`
+	Scope        *tg.CommandScope `tg:"scope"`
+	LanguageCode string           `tg:"language_code"`
+}
+
+// Method returns the method name.
+func (SetMyCommands) Method() string { return "setMyCommands" }
+
+// Do performs the request.
+func (p SetMyCommands) Do(ctx context.Context, a *api.API) error {
+	_, err := request[api.Empty](ctx, a, p)
+	return err
+}
+
+// SetMyDefaultAdministratorRights contains the parameters of the setMyDefaultAdministratorRights method.
+//
+// https://core.telegram.org/bots/api#setmydefaultadministratorrights
+type SetMyDefaultAdministratorRights struct {
+	Rights      *tg.ChatAdministratorRights `tg:"rights"`
+	ForChannels bool                        `tg:"for_channels"`
+}
+
+// Method returns the method name.
+func (SetMyDefaultAdministratorRights) Method() string { return "setMyDefaultAdministratorRights" }
+
+// Do performs the request.
+func (p SetMyDefaultAdministratorRights) Do(ctx context.Context, a *api.API) error {
+	_, err := request[api.Empty](ctx, a, p)
+	return err
+}
+
+// SetMyDescription contains the parameters of the setMyDescription method.
+//
+// https://core.telegram.org/bots/api#setmydescription
+type SetMyDescription struct {
+	Description  string `tg:"description"`
+	LanguageCode string `tg:"language_code"`
+}
+
+// Method returns the method name.
+func (SetMyDescription) Method() string { return "setMyDescription" }
+
+// Do performs the request.
+func (p SetMyDescription) Do(ctx context.Context, a *api.API) error {
+	_, err := request[api.Empty](ctx, a, p)
+	return err
+}
+
+// SetMyName contains the parameters of the setMyName method.
+//
+// https://core.telegram.org/bots/api#setmyname
+type SetMyName struct {
+	Name         string `tg:"name"`
+	LanguageCode string `tg:"language_code"`
+}
+
+// Method returns the method name.
+func (SetMyName) Method() string { return "setMyName" }
+
+// Do performs the request.
+func (p SetMyName) Do(ctx context.Context, a *api.API) error {
+	_, err := request[api.Empty](ctx, a, p)
+	return err
+}
+
+// SetMyShortDescription contains the parameters of the setMyShortDescription method.
+//
+// https://core.telegram.org/bots/api#setmyshortdescription
+type SetMyShortDescription struct {
+	ShortDescription string `tg:"short_description"`
+	LanguageCode     string `tg:"language_code"`
+}
+
+// Method returns the method name.
+func (SetMyShortDescription) Method() string { return "setMyShortDescription" }
+
+// Do performs the request.
+func (p SetMyShortDescription) Do(ctx context.Context, a *api.API) error {
+	_, err := request[api.Empty](ctx, a, p)
+	return err
+}
+
+// SetPassportDataErrors contains the parameters of the setPassportDataErrors method.
+//
+// https://core.telegram.org/bots/api#setpassportdataerrors
+type SetPassportDataErrors struct {
+	UserID tg.ID                     `tg:"user_id,force"`
+	Errors []tg.PassportElementError `tg:"errors"`
+}
+
+// Method returns the method name.
+func (SetPassportDataErrors) Method() string { return "setPassportDataErrors" }
+
+// Do performs the request.
+func (p SetPassportDataErrors) Do(ctx context.Context, a *api.API) error {
+	_, err := request[api.Empty](ctx, a, p)
+	return err
+}
+
+// SetStickerEmojiList contains the parameters of the setStickerEmojiList method.
+//
+// https://core.telegram.org/bots/api#setstickeremojilist
+type SetStickerEmojiList struct {
+	Sticker   string   `tg:"sticker"`
+	EmojiList []string `tg:"emoji_list"`
+}
+
+// Method returns the method name.
+func (SetStickerEmojiList) Method() string { return "setStickerEmojiList" }
+
+// Do performs the request.
+func (p SetStickerEmojiList) Do(ctx context.Context, a *api.API) error {
+	_, err := request[api.Empty](ctx, a, p)
+	return err
+}
+
+// SetStickerKeywords contains the parameters of the setStickerKeywords method.
+//
+// https://core.telegram.org/bots/api#setstickerkeywords
+type SetStickerKeywords struct {
+	Sticker  string   `tg:"sticker"`
+	Keywords []string `tg:"keywords"`
+}
+
+// Method returns the method name.
+func (SetStickerKeywords) Method() string { return "setStickerKeywords" }
+
+// Do performs the request.
+func (p SetStickerKeywords) Do(ctx context.Context, a *api.API) error {
+	_, err := request[api.Empty](ctx, a, p)
+	return err
+}
+
+// SetStickerMaskPosition contains the parameters of the setStickerMaskPosition method.
+//
+// https://core.telegram.org/bots/api#setstickermaskposition
+type SetStickerMaskPosition struct {
+	Sticker      string           `tg:"sticker"`
+	MaskPosition *tg.MaskPosition `tg:"mask_position"`
+}
+
+// Method returns the method name.
+func (SetStickerMaskPosition) Method() string { return "setStickerMaskPosition" }
+
+// Do performs the request.
+func (p SetStickerMaskPosition) Do(ctx context.Context, a *api.API) error {
+	_, err := request[api.Empty](ctx, a, p)
+	return err
+}
+
+// SetStickerPositionInSet contains the parameters of the setStickerPositionInSet method.
+//
+// https://core.telegram.org/bots/api#setstickerpositioninset
+type SetStickerPositionInSet struct {
+	Sticker  string `tg:"sticker"`
+	Position int    `tg:"position,force"`
+}
+
+// Method returns the method name.
+func (SetStickerPositionInSet) Method() string { return "setStickerPositionInSet" }
+
+// Do performs the request.
+func (p SetStickerPositionInSet) Do(ctx context.Context, a *api.API) error {
+	_, err := request[api.Empty](ctx, a, p)
+	return err
+}
+
+// SetStickerSetThumbnail contains the parameters of the setStickerSetThumbnail method.
+//
+// https://core.telegram.org/bots/api#setstickersetthumbnail
+type SetStickerSetThumbnail struct {
+	Name      string        `tg:"name"`
+	UserID    tg.ID         `tg:"user_id,force"`
+	Thumbnail tg.Inputtable `tg:"thumbnail"`
+	Format    string        `tg:"format"`
+}
+
+// Method returns the method name.
+func (SetStickerSetThumbnail) Method() string { return "setStickerSetThumbnail" }
+
+// Do performs the request.
+func (p SetStickerSetThumbnail) Do(ctx context.Context, a *api.API) error {
+	_, err := request[api.Empty](ctx, a, p)
+	return err
+}
+
+// SetStickerSetTitle contains the parameters of the setStickerSetTitle method.
+//
+// https://core.telegram.org/bots/api#setstickersettitle
+type SetStickerSetTitle struct {
+	Name  string `tg:"name"`
+	Title string `tg:"title"`
+}
+
+// Method returns the method name.
+func (SetStickerSetTitle) Method() string { return "setStickerSetTitle" }
+
+// Do performs the request.
+func (p SetStickerSetTitle) Do(ctx context.Context, a *api.API) error {
+	_, err := request[api.Empty](ctx, a, p)
+	return err
+}
+
+// SetUserEmojiStatus contains the parameters of the setUserEmojiStatus method.
+//
+// https://core.telegram.org/bots/api#setuseremojistatus
+type SetUserEmojiStatus struct {
+	UserID                    tg.ID   `tg:"user_id,force"`
+	EmojiStatusCustomEmojiID  string  `tg:"emoji_status_custom_emoji_id"`
+	EmojiStatusExpirationDate tg.Date `tg:"emoji_status_expiration_date"`
+}
+
+// Method returns the method name.
+func (SetUserEmojiStatus) Method() string { return "setUserEmojiStatus" }
+
+// Do performs the request.
+func (p SetUserEmojiStatus) Do(ctx context.Context, a *api.API) error {
+	_, err := request[api.Empty](ctx, a, p)
+	return err
+}
+
+// SetWebhook contains the parameters of the setWebhook method.
+//
+// https://core.telegram.org/bots/api#setwebhook
+type SetWebhook struct {
+	URL                string        `tg:"url"`
+	Certificate        tg.Inputtable `tg:"certificate"`
+	IPAddress          string        `tg:"ip_address"`
+	MaxConnections     int           `tg:"max_connections"`
+	AllowedUpdates     []string      `tg:"allowed_updates"`
+	DropPendingUpdates bool          `tg:"drop_pending_updates"`
+	SecretToken        string        `tg:"secret_token"`
+}
+
+// Method returns the method name.
+func (SetWebhook) Method() string { return "setWebhook" }
+
+// Do performs the request.
+func (p SetWebhook) Do(ctx context.Context, a *api.API) error {
+	_, err := request[api.Empty](ctx, a, p)
+	return err
+}
+
+// StopMessageLiveLocation contains the parameters of the stopMessageLiveLocation method.
+//
+// https://core.telegram.org/bots/api#stopmessagelivelocation
+type StopMessageLiveLocation struct {
+	BusinessConnectionID string                   `tg:"business_connection_id"`
+	ChatID               tg.ChatID                `tg:"chat_id"`
+	MessageID            tg.ID                    `tg:"message_id"`
+	InlineMessageID      string                   `tg:"inline_message_id"`
+	ReplyMarkup          *tg.InlineKeyboardMarkup `tg:"reply_markup"`
+}
+
+// Method returns the method name.
+func (StopMessageLiveLocation) Method() string { return "stopMessageLiveLocation" }
+
+// Do performs the request.
+// If the message is inline, the result is nil.
+func (p StopMessageLiveLocation) Do(ctx context.Context, a *api.API) (*tg.Message, error) {
+	if p.InlineMessageID != "" {
+		_, err := request[api.Empty](ctx, a, p)
+		return nil, err
+	}
+	return request[*tg.Message](ctx, a, p)
+}
+
+// StopPoll contains the parameters of the stopPoll method.
+//
+// https://core.telegram.org/bots/api#stoppoll
+type StopPoll struct {
+	BusinessConnectionID string                   `tg:"business_connection_id"`
+	ChatID               tg.ChatID                `tg:"chat_id"`
+	MessageID            tg.ID                    `tg:"message_id,force"`
+	ReplyMarkup          *tg.InlineKeyboardMarkup `tg:"reply_markup"`
+}
+
+// Method returns the method name.
+func (StopPoll) Method() string { return "stopPoll" }
+
+// Do performs the request.
+func (p StopPoll) Do(ctx context.Context, a *api.API) (*tg.Poll, error) {
+	return request[*tg.Poll](ctx, a, p)
+}
+
+// TransferBusinessAccountStars contains the parameters of the transferBusinessAccountStars method.
+//
+// https://core.telegram.org/bots/api#transferbusinessaccountstars
+type TransferBusinessAccountStars struct {
+	BusinessConnectionID string `tg:"business_connection_id"`
+	StarCount            int    `tg:"star_count,force"`
+}
+
+// Method returns the method name.
+func (TransferBusinessAccountStars) Method() string { return "transferBusinessAccountStars" }
+
+// Do performs the request.
+func (p TransferBusinessAccountStars) Do(ctx context.Context, a *api.API) error {
+	_, err := request[api.Empty](ctx, a, p)
+	return err
+}
+
+// TransferGift contains the parameters of the transferGift method.
+//
+// https://core.telegram.org/bots/api#transfergift
+type TransferGift struct {
+	BusinessConnectionID string `tg:"business_connection_id"`
+	OwnedGiftID          string `tg:"owned_gift_id"`
+	NewOwnerChatID       tg.ID  `tg:"new_owner_chat_id,force"`
+	StarCount            int    `tg:"star_count"`
+}
+
+// Method returns the method name.
+func (TransferGift) Method() string { return "transferGift" }
+
+// Do performs the request.
+func (p TransferGift) Do(ctx context.Context, a *api.API) error {
+	_, err := request[api.Empty](ctx, a, p)
+	return err
+}
+
+// UnbanChatMember contains the parameters of the unbanChatMember method.
+//
+// https://core.telegram.org/bots/api#unbanchatmember
+type UnbanChatMember struct {
+	ChatID       tg.ChatID `tg:"chat_id"`
+	UserID       tg.ID     `tg:"user_id,force"`
+	OnlyIfBanned bool      `tg:"only_if_banned"`
+}
+
+// Method returns the method name.
+func (UnbanChatMember) Method() string { return "unbanChatMember" }
+
+// Do performs the request.
+func (p UnbanChatMember) Do(ctx context.Context, a *api.API) error {
+	_, err := request[api.Empty](ctx, a, p)
+	return err
+}
+
+// UnbanChatSenderChat contains the parameters of the unbanChatSenderChat method.
+//
+// https://core.telegram.org/bots/api#unbanchatsenderchat
+type UnbanChatSenderChat struct {
+	ChatID       tg.ChatID `tg:"chat_id"`
+	SenderChatID tg.ID     `tg:"sender_chat_id,force"`
+}
+
+// Method returns the method name.
+func (UnbanChatSenderChat) Method() string { return "unbanChatSenderChat" }
+
+// Do performs the request.
+func (p UnbanChatSenderChat) Do(ctx context.Context, a *api.API) error {
+	_, err := request[api.Empty](ctx, a, p)
+	return err
+}
+
+// UnhideGeneralForumTopic contains the parameters of the unhideGeneralForumTopic method.
+//
+// https://core.telegram.org/bots/api#unhidegeneralforumtopic
+type UnhideGeneralForumTopic struct {
+	ChatID tg.ChatID `tg:"chat_id"`
+}
+
+// Method returns the method name.
+func (UnhideGeneralForumTopic) Method() string { return "unhideGeneralForumTopic" }
+
+// Do performs the request.
+func (p UnhideGeneralForumTopic) Do(ctx context.Context, a *api.API) error {
+	_, err := request[api.Empty](ctx, a, p)
+	return err
+}
+
+// UnpinAllChatMessages contains the parameters of the unpinAllChatMessages method.
+//
+// https://core.telegram.org/bots/api#unpinallchatmessages
+type UnpinAllChatMessages struct {
+	ChatID tg.ChatID `tg:"chat_id"`
+}
+
+// Method returns the method name.
+func (UnpinAllChatMessages) Method() string { return "unpinAllChatMessages" }
+
+// Do performs the request.
+func (p UnpinAllChatMessages) Do(ctx context.Context, a *api.API) error {
+	_, err := request[api.Empty](ctx, a, p)
+	return err
+}
+
+// UnpinAllForumTopicMessages contains the parameters of the unpinAllForumTopicMessages method.
+//
+// https://core.telegram.org/bots/api#unpinallforumtopicmessages
+type UnpinAllForumTopicMessages struct {
+	ChatID          tg.ChatID `tg:"chat_id"`
+	MessageThreadID tg.ID     `tg:"message_thread_id,force"`
+}
+
+// Method returns the method name.
+func (UnpinAllForumTopicMessages) Method() string { return "unpinAllForumTopicMessages" }
+
+// Do performs the request.
+func (p UnpinAllForumTopicMessages) Do(ctx context.Context, a *api.API) error {
+	_, err := request[api.Empty](ctx, a, p)
+	return err
+}
+
+// UnpinAllGeneralForumTopicMessages contains the parameters of the unpinAllGeneralForumTopicMessages method.
+//
+// https://core.telegram.org/bots/api#unpinallgeneralforumtopicmessages
+type UnpinAllGeneralForumTopicMessages struct {
+	ChatID tg.ChatID `tg:"chat_id"`
+}
+
+// Method returns the method name.
+func (UnpinAllGeneralForumTopicMessages) Method() string { return "unpinAllGeneralForumTopicMessages" }
+
+// Do performs the request.
+func (p UnpinAllGeneralForumTopicMessages) Do(ctx context.Context, a *api.API) error {
+	_, err := request[api.Empty](ctx, a, p)
+	return err
+}
+
+// UnpinChatMessage contains the parameters of the unpinChatMessage method.
+//
+// https://core.telegram.org/bots/api#unpinchatmessage
+type UnpinChatMessage struct {
+	BusinessConnectionID string    `tg:"business_connection_id"`
+	ChatID               tg.ChatID `tg:"chat_id"`
+	MessageID            tg.ID     `tg:"message_id"`
+}
+
+// Method returns the method name.
+func (UnpinChatMessage) Method() string { return "unpinChatMessage" }
+
+// Do performs the request.
+func (p UnpinChatMessage) Do(ctx context.Context, a *api.API) error {
+	_, err := request[api.Empty](ctx, a, p)
+	return err
+}
+
+// UpgradeGift contains the parameters of the upgradeGift method.
+//
+// https://core.telegram.org/bots/api#upgradegift
+type UpgradeGift struct {
+	BusinessConnectionID string `tg:"business_connection_id"`
+	OwnedGiftID          string `tg:"owned_gift_id"`
+	KeepOriginalDetails  bool   `tg:"keep_original_details"`
+	StarCount            int    `tg:"star_count"`
+}
+
+// Method returns the method name.
+func (UpgradeGift) Method() string { return "upgradeGift" }
+
+// Do performs the request.
+func (p UpgradeGift) Do(ctx context.Context, a *api.API) error {
+	_, err := request[api.Empty](ctx, a, p)
+	return err
+}
+
+// UploadStickerFile contains the parameters of the uploadStickerFile method.
+//
+// https://core.telegram.org/bots/api#uploadstickerfile
+type UploadStickerFile struct {
+	UserID        tg.ID         `tg:"user_id,force"`
+	Sticker       tg.Inputtable `tg:"sticker"`
+	StickerFormat string        `tg:"sticker_format"`
+}
+
+// Method returns the method name.
+func (UploadStickerFile) Method() string { return "uploadStickerFile" }
+
+// Do performs the request.
+func (p UploadStickerFile) Do(ctx context.Context, a *api.API) (*tg.File, error) {
+	return request[*tg.File](ctx, a, p)
+}
+
+// VerifyChat contains the parameters of the verifyChat method.
+//
+// https://core.telegram.org/bots/api#verifychat
+type VerifyChat struct {
+	ChatID            tg.ChatID `tg:"chat_id"`
+	CustomDescription string    `tg:"custom_description"`
+}
+
+// Method returns the method name.
+func (VerifyChat) Method() string { return "verifyChat" }
+
+// Do performs the request.
+func (p VerifyChat) Do(ctx context.Context, a *api.API) error {
+	_, err := request[api.Empty](ctx, a, p)
+	return err
+}
+
+// VerifyUser contains the parameters of the verifyUser method.
+//
+// https://core.telegram.org/bots/api#verifyuser
+type VerifyUser struct {
+	UserID            tg.ID  `tg:"user_id,force"`
+	CustomDescription string `tg:"custom_description"`
+}
+
+// Method returns the method name.
+func (VerifyUser) Method() string { return "verifyUser" }
+
+// Do performs the request.
+func (p VerifyUser) Do(ctx context.Context, a *api.API) error {
+	_, err := request[api.Empty](ctx, a, p)
+	return err
+}
@@ -15,12 +15,15 @@ type Response[Type any] struct {
 
 // Error describes telegram api error.
 type Error struct {
-	Code        int    `json:"error_code"`
-	Description string `json:"description"`
-	Parameters  *struct {
-		MigrateTo  *ID       `json:"migrate_to_chat_id"`
-		RetryAfter *Duration `json:"retry_after"`
-	} `json:"parameters"`
+	Code        int                 `json:"error_code"`
+	Description string              `json:"description"`
+	Parameters  *ResponseParameters `json:"parameters"`
+}
+
+// ResponseParameters describes why a request was unsuccessful.
+type ResponseParameters struct {
+	MigrateTo  *ID       `json:"migrate_to_chat_id"`
+	RetryAfter *Duration `json:"retry_after"`
 }
 
 func (e *Error) Error() string {
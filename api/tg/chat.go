@@ -206,7 +206,7 @@ type ChatMember struct {
 
 func (m ChatMember) Status() ChatMemberStatus { return m.Member.Type() }
 
-func (m ChatMember) UnmarshalJSON(p []byte) error {
+func (m *ChatMember) UnmarshalJSON(p []byte) error {
 	var status struct {
 		Status ChatMemberStatus `json:"status"`
 		User   User             `json:"user"`
@@ -3,6 +3,7 @@ package tg
 import (
 	"bytes"
 	"io"
+	"io/fs"
 
 	"github.com/karalef/tgot/api/internal"
 	"github.com/karalef/tgot/api/internal/oneof"
@@ -31,6 +32,14 @@ type InputFile struct {
 	Name string
 	Data io.Reader
 
+	// Size is the file size in bytes, 0 if unknown.
+	// It is used to check the upload limits before the request.
+	Size int64
+
+	// Progress is called after each chunk of the file is sent.
+	// The total is the Size.
+	Progress func(sent, total int64)
+
 	field string
 }
 
@@ -51,12 +60,34 @@ func (f InputFile) MarshalJSON() ([]byte, error) {
 	return []byte("\"" + "attach://" + f.field + "\""), nil
 }
 
+// WithProgress sets the progress callback.
+func (f *InputFile) WithProgress(progress func(sent, total int64)) *InputFile {
+	f.Progress = progress
+	return f
+}
+
 // FileReader creates the InputFile from reader.
+// The size is detected if the reader has the Len or Stat method
+// (like bytes.Reader or os.File).
 func FileReader(name string, r io.Reader) *InputFile {
 	return &InputFile{
 		Name: name,
 		Data: r,
+		Size: readerSize(r),
+	}
+}
+
+func readerSize(r io.Reader) int64 {
+	switch r := r.(type) {
+	case interface{ Len() int }:
+		return int64(r.Len())
+	case interface{ Stat() (fs.FileInfo, error) }:
+		fi, err := r.Stat()
+		if err == nil && fi.Mode().IsRegular() {
+			return fi.Size()
+		}
 	}
+	return 0
 }
 
 // FileBytes creates the InputFile from bytes.
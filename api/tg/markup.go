@@ -37,7 +37,7 @@ type KeyboardButton struct {
 	RequestContact  bool                        `json:"request_contact,omitempty"`
 	RequestLocation bool                        `json:"request_location,omitempty"`
 	RequestPoll     *ButtonPollType             `json:"request_poll,omitempty"`
-	WebApp          *WebAppInfo                 `json:"web_app,omitmepty"`
+	WebApp          *WebAppInfo                 `json:"web_app,omitempty"`
 }
 
 // KeyboardButtonRequestUsers defines the criteria used to request a suitable user.
@@ -60,8 +60,8 @@ type KeyboardButtonRequestChat struct {
 	ChatIsForum     *bool                    `json:"chat_is_forum,omitempty"`
 	ChatHasUsername *bool                    `json:"chat_has_username,omitempty"`
 	ChatIsCreated   bool                     `json:"chat_is_created,omitempty"`
-	UserAdminRights *ChatAdministratorRights `json:"user_admin_rights,omitempty"`
-	BotAdminRights  *ChatAdministratorRights `json:"bot_admin_rights,omitempty"`
+	UserAdminRights *ChatAdministratorRights `json:"user_administrator_rights,omitempty"`
+	BotAdminRights  *ChatAdministratorRights `json:"bot_administrator_rights,omitempty"`
 	BotIsMember     bool                     `json:"bot_is_member,omitempty"`
 	RequestTitle    bool                     `json:"request_title,omitempty"`
 	RequestUsername bool                     `json:"request_username,omitempty"`
@@ -124,6 +124,49 @@ type InlineKeyboardButton struct {
 	CopyText            *CopyTextButton              `json:"copy_text,omitempty"`
 	CallbackGame        *CallbackGame                `json:"callback_game,omitempty"`
 	Pay                 bool                         `json:"pay,omitempty"`
+
+	// SwitchInlineEmpty and SwitchInlineCurrentEmpty make the button switch
+	// to the inline mode with the empty query (only the bot's username is
+	// inserted) if SwitchInline and SwitchInlineCurrent are empty.
+	SwitchInlineEmpty        bool `json:"-"`
+	SwitchInlineCurrentEmpty bool `json:"-"`
+}
+
+// MarshalJSON is json.Marshaler implementation.
+func (b InlineKeyboardButton) MarshalJSON() ([]byte, error) {
+	type button InlineKeyboardButton
+	v := struct {
+		button
+		SwitchInline        *string `json:"switch_inline_query,omitempty"`
+		SwitchInlineCurrent *string `json:"switch_inline_query_current_chat,omitempty"`
+	}{button: button(b)}
+	if b.SwitchInline != "" || b.SwitchInlineEmpty {
+		v.SwitchInline = &b.SwitchInline
+	}
+	if b.SwitchInlineCurrent != "" || b.SwitchInlineCurrentEmpty {
+		v.SwitchInlineCurrent = &b.SwitchInlineCurrent
+	}
+	return json.Marshal(v)
+}
+
+// UnmarshalJSON is json.Unmarshaler implementation.
+func (b *InlineKeyboardButton) UnmarshalJSON(p []byte) error {
+	type button InlineKeyboardButton
+	v := struct {
+		*button
+		SwitchInline        *string `json:"switch_inline_query"`
+		SwitchInlineCurrent *string `json:"switch_inline_query_current_chat"`
+	}{button: (*button)(b)}
+	if err := json.Unmarshal(p, &v); err != nil {
+		return err
+	}
+	if v.SwitchInline != nil {
+		b.SwitchInline, b.SwitchInlineEmpty = *v.SwitchInline, *v.SwitchInline == ""
+	}
+	if v.SwitchInlineCurrent != nil {
+		b.SwitchInlineCurrent, b.SwitchInlineCurrentEmpty = *v.SwitchInlineCurrent, *v.SwitchInlineCurrent == ""
+	}
+	return nil
 }
 
 // CopyTextButton represents an inline keyboard button that copies specified text to the clipboard.
@@ -134,10 +177,10 @@ type CopyTextButton struct {
 // SwitchInlineQueryChosenChat represents an inline button that switches the current user to inline mode in a chosen chat, with an optional default inline query.
 type SwitchInlineQueryChosenChat struct {
 	Query            string `json:"query"`
-	AllowUserChat    bool   `json:"allow_user_chat,omitempty"`
-	AllowBotChat     bool   `json:"allow_bot_chat,omitempty"`
-	AllowGroupChat   bool   `json:"allow_group_chat,omitempty"`
-	AllowChannelChat bool   `json:"allow_channel_chat,omitempty"`
+	AllowUserChat    bool   `json:"allow_user_chats,omitempty"`
+	AllowBotChat     bool   `json:"allow_bot_chats,omitempty"`
+	AllowGroupChat   bool   `json:"allow_group_chats,omitempty"`
+	AllowChannelChat bool   `json:"allow_channel_chats,omitempty"`
 }
 
 // LoginURL represents a parameter of the inline keyboard button
@@ -2,6 +2,7 @@ package tg
 
 import (
 	"encoding/json"
+	"unicode/utf8"
 
 	"github.com/karalef/tgot/api/internal/oneof"
 )
@@ -113,12 +114,31 @@ type MessageEntity struct {
 	Type          EntityType `json:"type"`
 	Offset        int        `json:"offset"` // in UTF-16
 	Length        int        `json:"length"`
-	URL           string     `json:"url,omitmepty"`
-	User          *User      `json:"user,omitmepty"`
-	Language      string     `json:"language,omitmepty"`
+	URL           string     `json:"url,omitempty"`
+	User          *User      `json:"user,omitempty"`
+	Language      string     `json:"language,omitempty"`
 	CustomEmojiID string     `json:"custom_emoji_id,omitempty"`
 }
 
+// UTF16Len returns the length of the string in UTF-16 code units
+// in which the entity offsets and lengths are measured.
+func UTF16Len(s string) int {
+	n := 0
+	for _, r := range s {
+		n += UTF16RuneLen(r)
+	}
+	return n
+}
+
+// UTF16RuneLen returns the number of UTF-16 code units needed to encode
+// the rune.
+func UTF16RuneLen(r rune) int {
+	if r >= 0x10000 && r <= utf8.MaxRune {
+		return 2
+	}
+	return 1
+}
+
 // EntityType is a MessageEntity type.
 type EntityType string
 
@@ -234,7 +254,7 @@ type AutoDeleteTimer struct {
 
 // VideoChatScheduled represents a service message about a video chat scheduled in the chat.
 type VideoChatScheduled struct {
-	Time Date `json:"start_time"`
+	Time Date `json:"start_date"`
 }
 
 // VideoChatStarted represents a service message about a video chat started in the chat.
@@ -604,7 +624,7 @@ func (BackgroundTypeFill) Type() BackgroundTypeType { return BackgroundTypeTypeF
 type BackgroundTypeWallpaper struct {
 	Document         *Document `json:"document"`
 	DarkThemeDimming uint8     `json:"dark_theme_dimming"`
-	IsBlured         bool      `json:"is_blured"`
+	IsBlurred        bool      `json:"is_blurred"`
 	IsMoving         bool      `json:"is_moving"`
 }
 
@@ -113,6 +113,11 @@ type InlineQuery struct {
 
 // InlineChosen represents a result of an inline query that was chosen
 // by the user and sent to their chat partner.
+//
+// It is necessary to enable inline feedback via @BotFather
+// (/setinlinefeedback) in order to receive these objects in updates.
+// InlineMessageID is available only if there is an inline keyboard attached
+// to the message.
 type InlineChosen struct {
 	ResultID        string    `json:"result_id"`
 	From            User      `json:"from"`
@@ -0,0 +1,110 @@
+package api
+
+import (
+	"context"
+	"fmt"
+	"io"
+
+	"github.com/karalef/tgot/api/tg"
+)
+
+// Limits contains the maximum sizes of the uploaded files in bytes.
+// The zero field means no limit.
+type Limits struct {
+	Photo int64
+	File  int64
+}
+
+// CloudLimits are the upload limits of the cloud Bot API server.
+var CloudLimits = Limits{
+	Photo: 10 << 20,
+	File:  50 << 20,
+}
+
+// LocalLimits are the upload limits of the local Bot API server.
+var LocalLimits = Limits{
+	Photo: 10 << 20,
+	File:  2000 << 20,
+}
+
+// FileTooLargeError is returned if the file exceeds the upload limit.
+type FileTooLargeError struct {
+	Field string
+	Name  string
+	Size  int64
+	Limit int64
+}
+
+func (e *FileTooLargeError) Error() string {
+	return fmt.Sprintf("file %s (%s) is too large: %d bytes, limit is %d bytes",
+		e.Name, e.Field, e.Size, e.Limit)
+}
+
+// CheckLimits checks the sizes of the files to be uploaded.
+// The files of unknown size are not checked.
+func (d *Data) CheckLimits(l Limits) error {
+	if d == nil {
+		return nil
+	}
+	for field, f := range d.Upload {
+		limit := l.File
+		if _, ok := d.photos[f]; ok {
+			limit = l.Photo
+		}
+		if limit > 0 && f.Size > limit {
+			return &FileTooLargeError{
+				Field: field,
+				Name:  f.Name,
+				Size:  f.Size,
+				Limit: limit,
+			}
+		}
+	}
+	return nil
+}
+
+func (d *Data) markPhoto(f *tg.InputFile) {
+	if d.photos == nil {
+		d.photos = make(map[*tg.InputFile]struct{})
+	}
+	d.photos[f] = struct{}{}
+}
+
+// markPhotos marks the photos among the input media attachments.
+func (d *Data) markPhotos(v tg.Inputter) {
+	var media tg.Inputtable
+	switch v := v.(type) {
+	case tg.InputMedia:
+		if _, ok := v.Data.(*tg.InputMediaPhoto); ok {
+			media = v.Media
+		}
+	case tg.InputPaidMedia:
+		if _, ok := v.Data.(*tg.InputPaidMediaPhoto); ok {
+			media = v.Media
+		}
+	}
+	if f, ok := media.(*tg.InputFile); ok && f != nil {
+		d.markPhoto(f)
+	}
+}
+
+// fileReader reports the upload progress and stops reading when the
+// context is done.
+type fileReader struct {
+	ctx  context.Context
+	r    io.Reader
+	f    *tg.InputFile
+	sent int64
+}
+
+func (r *fileReader) Read(p []byte) (int, error) {
+	if err := r.ctx.Err(); err != nil {
+		return 0, err
+	}
+	n, err := r.r.Read(p)
+	if n > 0 && r.f.Progress != nil {
+		r.sent += int64(n)
+		r.f.Progress(r.sent, r.f.Size)
+	}
+	return n, err
+}
@@ -78,7 +78,7 @@ func (c *Chat) method(meth string, d ...*api.Data) error {
 
 // SendE sends the Sendable and returns only an error.
 func (c *Chat) SendE(s Sendable, opts ...SendOptions) error {
-	_, err := c.Send(s)
+	_, err := c.Send(s, opts...)
 	return err
 }
 
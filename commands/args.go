@@ -0,0 +1,486 @@
+package commands
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/karalef/tgot/api/cmd"
+	"github.com/karalef/tgot/api/tg"
+)
+
+// UserRef references a user by username or id.
+type UserRef struct {
+	ID       tg.ID
+	Username string
+
+	// User is set if the user is referenced by the text mention.
+	User *tg.User
+}
+
+func userRefFrom(t cmd.Token) (UserRef, error) {
+	if u := t.User(); u != nil {
+		return UserRef{ID: u.ID, User: u}, nil
+	}
+	return ParseUserRef(t.Value)
+}
+
+// ParseUserRef parses '@username' or numeric user id.
+func ParseUserRef(s string) (UserRef, error) {
+	if name, ok := strings.CutPrefix(s, "@"); ok && name != "" {
+		return UserRef{Username: name}, nil
+	}
+	id, err := strconv.ParseInt(s, 10, 64)
+	if err != nil {
+		return UserRef{}, err
+	}
+	return UserRef{ID: tg.ID(id)}, nil
+}
+
+// ParseChatID parses '@username' or numeric chat id.
+func ParseChatID(s string) (tg.ChatID, error) {
+	if name, ok := strings.CutPrefix(s, "@"); ok && name != "" {
+		return tg.Username(s), nil
+	}
+	id, err := strconv.ParseInt(s, 10, 64)
+	if err != nil {
+		return nil, err
+	}
+	return tg.ID(id), nil
+}
+
+// ParseArgs parses the command arguments into the struct pointed to by dst.
+//
+// The struct fields are declared as arguments using the "arg" tag key with
+// pattern `arg:"name,opt1,opt2,..."`. The name can be omitted (the field name
+// in lower case is used) or replaced with '-' to ignore the field.
+// The fields without the "named" option are positional and are filled in the
+// order of declaration.
+//
+// Supported options:
+//   - required: the argument must be provided;
+//   - named: the argument is passed as 'name=value' at any position;
+//   - rest: the argument takes all remaining positional arguments
+//     (string joins them with a space, []string keeps them as is);
+//   - enum=a|b|c: the value must be one of the constants (case-insensitive).
+//
+// The "default" tag key specifies the value used if the argument is omitted.
+//
+// Supported field types: string, bool, int*, uint*, float*, time.Duration,
+// tg.ID, tg.ChatID, UserRef and []string (only with the 'rest' option).
+// UserRef also accepts the text mentions if the arguments are parsed by
+// ParseTokens.
+//
+// The unsupported types are reported by CheckArgs and by ParseArgs.
+//
+// If the arguments are invalid, the returned error is *UsageError.
+func ParseArgs(dst any, args []string) error {
+	return ParseTokens(dst, plainTokens(args))
+}
+
+func plainTokens(args []string) []cmd.Token {
+	tokens := make([]cmd.Token, len(args))
+	for i := range args {
+		tokens[i].Value = args[i]
+	}
+	return tokens
+}
+
+func tokenValues(tokens []cmd.Token) []string {
+	if len(tokens) == 0 {
+		return nil
+	}
+	args := make([]string, len(tokens))
+	for i := range tokens {
+		args[i] = tokens[i].Value
+	}
+	return args
+}
+
+// ParseTokens is like ParseArgs but uses the entities of the tokens.
+// For example, UserRef is resolved from the text mention.
+func ParseTokens(dst any, tokens []cmd.Token) error {
+	val := reflect.ValueOf(dst)
+	if val.Kind() != reflect.Ptr || val.Elem().Kind() != reflect.Struct {
+		panic("commands: ParseArgs requires a pointer to struct")
+	}
+	val = val.Elem()
+	s := specOf(val.Type())
+	if s.err != nil {
+		return s.err
+	}
+	return s.parse(val, tokens)
+}
+
+// CheckArgs checks the declaration of the arguments by the struct v.
+// It returns an error if the struct has a field of an unsupported type.
+func CheckArgs(v any) error {
+	typ := reflect.TypeOf(v)
+	if typ != nil && typ.Kind() == reflect.Ptr {
+		typ = typ.Elem()
+	}
+	if typ == nil || typ.Kind() != reflect.Struct {
+		return errors.New("commands: arguments must be declared by a struct")
+	}
+	return specOf(typ).err
+}
+
+// ArgsOf returns the arguments declared by the struct v.
+// See ParseArgs for the declaration format.
+func ArgsOf(v any) []Arg {
+	typ := reflect.TypeOf(v)
+	if typ != nil && typ.Kind() == reflect.Ptr {
+		typ = typ.Elem()
+	}
+	if typ == nil || typ.Kind() != reflect.Struct {
+		return nil
+	}
+	return specOf(typ).args
+}
+
+// FormatArgs formats the struct v as the arguments accepted by ParseArgs.
+// The zero named and trailing positional fields are omitted, so the default
+// values are used for them when parsed.
+func FormatArgs(v any) []string {
+	val := reflect.ValueOf(v)
+	if val.Kind() == reflect.Ptr {
+		val = val.Elem()
+	}
+	if val.Kind() != reflect.Struct {
+		panic("commands: FormatArgs requires a struct")
+	}
+	s := specOf(val.Type())
+	positional := s.positional
+	for len(positional) > 0 && val.Field(positional[len(positional)-1].index).IsZero() {
+		positional = positional[:len(positional)-1]
+	}
+	args := make([]string, 0, len(s.all))
+	for _, a := range s.all {
+		if f := val.Field(a.index); a.Named && !f.IsZero() {
+			args = append(args, a.Name+"="+formatArg(f))
+		}
+	}
+	for _, a := range positional {
+		f := val.Field(a.index)
+		if a.rest && f.Type() == stringsType {
+			args = append(args, f.Interface().([]string)...)
+			continue
+		}
+		args = append(args, formatArg(f))
+	}
+	return args
+}
+
+func formatArg(v reflect.Value) string {
+	switch v.Type() {
+	case userRefType:
+		ref := v.Interface().(UserRef)
+		if ref.Username != "" {
+			return "@" + ref.Username
+		}
+		return strconv.FormatInt(int64(ref.ID), 10)
+	case chatIDType:
+		if v.IsNil() {
+			return ""
+		}
+	}
+	return fmt.Sprint(v.Interface())
+}
+
+// UsageErrorKind is a kind of UsageError.
+type UsageErrorKind uint8
+
+// all usage error kinds.
+const (
+	ErrMissingArg UsageErrorKind = iota
+	ErrInvalidArg
+	ErrEnumArg
+	ErrExtraArg
+)
+
+// UsageError is returned when the command arguments are invalid.
+type UsageError struct {
+	Kind  UsageErrorKind
+	Arg   Arg
+	Value string
+	Err   error
+}
+
+func (e *UsageError) Unwrap() error { return e.Err }
+
+func (e *UsageError) Error() string { return DefaultUsageMessages.Format(e) }
+
+// UsageMessages contains format strings for the usage errors.
+// The arguments are the argument name, the value and the constants joined
+// with a comma, so the formats should use explicit argument indexes.
+type UsageMessages struct {
+	Missing string
+	Invalid string
+	Enum    string
+	Extra   string
+}
+
+// DefaultUsageMessages contains english usage error messages.
+var DefaultUsageMessages = UsageMessages{
+	Missing: "missing required argument %[1]s",
+	Invalid: "invalid value %[2]q for argument %[1]s",
+	Enum:    "argument %[1]s must be one of: %[3]s",
+	Extra:   "unexpected argument %[2]q",
+}
+
+// Format formats the usage error.
+func (m UsageMessages) Format(e *UsageError) string {
+	var format string
+	switch e.Kind {
+	case ErrMissingArg:
+		format = m.Missing
+	case ErrInvalidArg:
+		format = m.Invalid
+	case ErrEnumArg:
+		format = m.Enum
+	case ErrExtraArg:
+		format = m.Extra
+	}
+	return fmt.Sprintf(format, e.Arg.Name, e.Value, strings.Join(e.Arg.Consts, ", "))
+}
+
+// Localization contains usage error messages by language code.
+type Localization map[string]UsageMessages
+
+// For returns the messages for the language code.
+// If there is no exact match, it tries the base language ('en' for 'en-US')
+// and falls back to DefaultUsageMessages.
+func (l Localization) For(lang string) UsageMessages {
+	if m, ok := l[lang]; ok {
+		return m
+	}
+	if base, _, ok := strings.Cut(lang, "-"); ok {
+		if m, ok := l[base]; ok {
+			return m
+		}
+	}
+	return DefaultUsageMessages
+}
+
+var specs sync.Map // map[reflect.Type]*argsSpec
+
+type argsSpec struct {
+	err        error
+	args       []Arg
+	all        []*argSpec
+	positional []*argSpec
+	named      map[string]*argSpec
+}
+
+type argSpec struct {
+	Arg
+	index  int
+	rest   bool
+	def    string
+	hasDef bool
+	parse  func(cmd.Token) (reflect.Value, error)
+}
+
+func specOf(typ reflect.Type) *argsSpec {
+	if s, ok := specs.Load(typ); ok {
+		return s.(*argsSpec)
+	}
+	s, _ := specs.LoadOrStore(typ, newArgsSpec(typ))
+	return s.(*argsSpec)
+}
+
+func newArgsSpec(typ reflect.Type) *argsSpec {
+	s := &argsSpec{named: make(map[string]*argSpec)}
+	for i := 0; i < typ.NumField(); i++ {
+		field := typ.Field(i)
+		if !field.IsExported() {
+			continue
+		}
+		tag := strings.Split(field.Tag.Get("arg"), ",")
+		if tag[0] == "-" {
+			continue
+		}
+		a := &argSpec{index: i}
+		a.Name = tag[0]
+		if a.Name == "" {
+			a.Name = strings.ToLower(field.Name)
+		}
+		for _, opt := range tag[1:] {
+			switch {
+			case opt == "required":
+				a.Required = true
+			case opt == "named":
+				a.Named = true
+			case opt == "rest":
+				a.rest = true
+			case strings.HasPrefix(opt, "enum="):
+				a.Consts = strings.Split(opt[len("enum="):], "|")
+			}
+		}
+		a.def, a.hasDef = field.Tag.Lookup("default")
+		if !a.rest || field.Type != stringsType {
+			a.parse = parserFor(field.Type)
+			if a.parse == nil && s.err == nil {
+				s.err = fmt.Errorf("commands: unsupported type %s of argument %s", field.Type, field.Name)
+			}
+		}
+
+		s.args = append(s.args, a.Arg)
+		s.all = append(s.all, a)
+		if a.Named {
+			s.named[a.Name] = a
+		} else {
+			s.positional = append(s.positional, a)
+		}
+	}
+	return s
+}
+
+var (
+	stringsType  = reflect.TypeOf([]string(nil))
+	durationType = reflect.TypeOf(time.Duration(0))
+	chatIDType   = reflect.TypeOf((*tg.ChatID)(nil)).Elem()
+	userRefType  = reflect.TypeOf(UserRef{})
+)
+
+// parserFor returns the parser for the argument type or nil if the type is
+// not supported.
+func parserFor(typ reflect.Type) func(cmd.Token) (reflect.Value, error) {
+	conv := func(v any, err error) (reflect.Value, error) {
+		if err != nil {
+			return reflect.Value{}, err
+		}
+		return reflect.ValueOf(v).Convert(typ), nil
+	}
+	switch typ {
+	case durationType:
+		return func(t cmd.Token) (reflect.Value, error) { return conv(time.ParseDuration(t.Value)) }
+	case chatIDType:
+		return func(t cmd.Token) (reflect.Value, error) {
+			id, err := ParseChatID(t.Value)
+			if err != nil {
+				return reflect.Value{}, err
+			}
+			v := reflect.New(chatIDType).Elem()
+			v.Set(reflect.ValueOf(id))
+			return v, nil
+		}
+	case userRefType:
+		return func(t cmd.Token) (reflect.Value, error) { return conv(userRefFrom(t)) }
+	}
+	switch typ.Kind() {
+	case reflect.String:
+		return func(t cmd.Token) (reflect.Value, error) { return conv(t.Value, nil) }
+	case reflect.Bool:
+		return func(t cmd.Token) (reflect.Value, error) { return conv(parseBool(t.Value)) }
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return func(t cmd.Token) (reflect.Value, error) {
+			return conv(strconv.ParseInt(t.Value, 10, typ.Bits()))
+		}
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return func(t cmd.Token) (reflect.Value, error) {
+			return conv(strconv.ParseUint(t.Value, 10, typ.Bits()))
+		}
+	case reflect.Float32, reflect.Float64:
+		return func(t cmd.Token) (reflect.Value, error) {
+			return conv(strconv.ParseFloat(t.Value, typ.Bits()))
+		}
+	}
+	return nil
+}
+
+func parseBool(s string) (bool, error) {
+	switch strings.ToLower(s) {
+	case "yes", "y", "on":
+		return true, nil
+	case "no", "n", "off":
+		return false, nil
+	}
+	return strconv.ParseBool(s)
+}
+
+func (s *argsSpec) parse(dst reflect.Value, tokens []cmd.Token) error {
+	set := make([]bool, dst.NumField())
+	pos := 0
+	for i := 0; i < len(tokens); i++ {
+		if name, value, ok := strings.Cut(tokens[i].Value, "="); ok && tokens[i].Entity == nil {
+			if a, ok := s.named[name]; ok {
+				if err := a.set(dst, cmd.Token{Value: value}); err != nil {
+					return err
+				}
+				set[a.index] = true
+				continue
+			}
+		}
+		if pos >= len(s.positional) {
+			return &UsageError{Kind: ErrExtraArg, Value: tokens[i].Value}
+		}
+		a := s.positional[pos]
+		set[a.index] = true
+		pos++
+		if !a.rest {
+			if err := a.set(dst, tokens[i]); err != nil {
+				return err
+			}
+			continue
+		}
+		rest := make([]string, len(tokens)-i)
+		for j := range rest {
+			rest[j] = tokens[i+j].Value
+		}
+		if a.parse == nil {
+			dst.Field(a.index).Set(reflect.ValueOf(rest))
+		} else if err := a.set(dst, cmd.Token{Value: strings.Join(rest, " ")}); err != nil {
+			return err
+		}
+		break
+	}
+
+	for _, a := range s.all {
+		if err := a.finish(dst, set[a.index]); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (a *argSpec) finish(dst reflect.Value, set bool) error {
+	switch {
+	case set:
+		return nil
+	case a.hasDef && a.parse != nil:
+		return a.set(dst, cmd.Token{Value: a.def})
+	case a.Required:
+		return &UsageError{Kind: ErrMissingArg, Arg: a.Arg}
+	}
+	return nil
+}
+
+func (a *argSpec) set(dst reflect.Value, t cmd.Token) error {
+	if len(a.Consts) > 0 {
+		i := indexFold(a.Consts, t.Value)
+		if i == -1 {
+			return &UsageError{Kind: ErrEnumArg, Arg: a.Arg, Value: t.Value}
+		}
+		t.Value = a.Consts[i]
+	}
+	v, err := a.parse(t)
+	if err != nil {
+		return &UsageError{Kind: ErrInvalidArg, Arg: a.Arg, Value: t.Value, Err: err}
+	}
+	dst.Field(a.index).Set(v)
+	return nil
+}
+
+func indexFold(list []string, s string) int {
+	for i := range list {
+		if strings.EqualFold(list[i], s) {
+			return i
+		}
+	}
+	return -1
+}
@@ -0,0 +1,150 @@
+package commands
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/karalef/tgot"
+	"github.com/karalef/tgot/api/cmd"
+	"github.com/karalef/tgot/api/deeplink"
+	"github.com/karalef/tgot/api/tg"
+)
+
+type banArgs struct {
+	User   UserRef       `arg:"user,required"`
+	For    time.Duration `arg:"for" default:"1h"`
+	Mode   string        `arg:"mode,named,enum=soft|hard" default:"soft"`
+	Chat   tg.ChatID     `arg:"chat,named"`
+	Reason string        `arg:"reason,rest"`
+}
+
+func TestParseArgs(t *testing.T) {
+	var a banArgs
+	err := ParseArgs(&a, cmd.Split(`@someone 10m mode=HARD "spam and flood" again`))
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := banArgs{
+		User:   UserRef{Username: "someone"},
+		For:    10 * time.Minute,
+		Mode:   "hard",
+		Reason: "spam and flood again",
+	}
+	if !reflect.DeepEqual(a, expected) {
+		t.Fatalf("expected %+v, got %+v", expected, a)
+	}
+
+	a = banArgs{}
+	if err = ParseArgs(&a, []string{"123", "chat=@channel"}); err != nil {
+		t.Fatal(err)
+	}
+	if a.User.ID != 123 || a.For != time.Hour || a.Mode != "soft" || a.Chat != tg.Username("@channel") {
+		t.Fatalf("unexpected result %+v", a)
+	}
+}
+
+func TestParseArgsErrors(t *testing.T) {
+	tests := []struct {
+		args []string
+		kind UsageErrorKind
+	}{
+		{nil, ErrMissingArg},
+		{[]string{"@user", "forever"}, ErrInvalidArg},
+		{[]string{"@user", "mode=medium"}, ErrEnumArg},
+	}
+	for _, test := range tests {
+		var a banArgs
+		var uerr *UsageError
+		if err := ParseArgs(&a, test.args); !errors.As(err, &uerr) || uerr.Kind != test.kind {
+			t.Fatalf("%v: expected error kind %d, got %v", test.args, test.kind, err)
+		}
+	}
+
+	var noRest struct {
+		A int `arg:"a"`
+	}
+	var uerr *UsageError
+	if err := ParseArgs(&noRest, []string{"1", "2"}); !errors.As(err, &uerr) || uerr.Kind != ErrExtraArg {
+		t.Fatalf("expected extra argument error, got %v", err)
+	}
+}
+
+func TestTypedHelp(t *testing.T) {
+	c := TypedCommand[banArgs]{Command: "ban", Desc: "ban user"}
+	const expected = "ban - ban user\n\nUsage:\n" +
+		`/ban [user] {for} {mode="soft"|"hard"} {chat=...} {reason}`
+	if h := c.Help(); h.Text != expected {
+		t.Fatalf("expected %q, got %q", expected, h.Text)
+	}
+}
+
+func TestFormatArgs(t *testing.T) {
+	a := banArgs{
+		User:   UserRef{Username: "someone"},
+		For:    10 * time.Minute,
+		Mode:   "hard",
+		Chat:   tg.ID(-100),
+		Reason: "spam",
+	}
+	param, err := EncodeStart("ban", a)
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, args, err := deeplink.DecodeStart(param)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var parsed banArgs
+	if err = ParseArgs(&parsed, args); err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(a, parsed) {
+		t.Fatalf("expected %+v, got %+v", a, parsed)
+	}
+}
+
+func TestTypedTokens(t *testing.T) {
+	user := &tg.User{ID: 42}
+	tokens := cmd.Tokenize("/ban John 10m", 5, []tg.MessageEntity{
+		{Type: tg.EntityCommand, Offset: 0, Length: 4},
+		{Type: tg.EntityTextMention, Offset: 5, Length: 4, User: user},
+	})
+	var got banArgs
+	c := TypedCommand[banArgs]{
+		Command: "ban",
+		Func: func(_ *tgot.Message, _ *tg.Message, a banArgs) error {
+			got = a
+			return nil
+		},
+	}
+	c.runTokens(nil, &tg.Message{}, "ban", tokens)
+	if got.User.User != user || got.User.ID != 42 || got.For != 10*time.Minute {
+		t.Fatalf("unexpected result %+v", got)
+	}
+}
+
+func TestCheckArgs(t *testing.T) {
+	type invalid struct {
+		Ch chan int `arg:"ch"`
+	}
+	if err := CheckArgs(banArgs{}); err != nil {
+		t.Fatal(err)
+	}
+	if err := CheckArgs(invalid{}); err == nil {
+		t.Fatal("unsupported type is not reported")
+	}
+	var a invalid
+	if err := ParseArgs(&a, []string{"1"}); err == nil {
+		t.Fatal("unsupported type is not reported by ParseArgs")
+	}
+
+	list := List{Group{
+		Command:  "admin",
+		Commands: List{TypedCommand[invalid]{Command: "bad"}},
+	}}
+	if err := list.Validate(); err == nil {
+		t.Fatal("invalid subcommand is not reported")
+	}
+}
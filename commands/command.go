@@ -32,6 +32,16 @@ type SimpleCommand struct {
 	Args     []Arg
 	Desc     string
 	FullDesc string
+
+	// Guard restricts who can run the command.
+	Guard *Guard
+
+	// Menu declares the scopes and localized descriptions of the command
+	// in the bot's menu (see List.SyncMenus).
+	Menu *Menu
+
+	// Cooldown limits how often the command can be run.
+	Cooldown *Cooldown
 }
 
 // Arg type.
@@ -39,6 +49,9 @@ type Arg struct {
 	Name     string
 	Consts   []string
 	Required bool
+
+	// Named argument is passed as 'name=value'.
+	Named bool
 }
 
 // Name returns command name.
@@ -47,6 +60,15 @@ func (c SimpleCommand) Name() string { return c.Command }
 // Description returns command description.
 func (c SimpleCommand) Description() string { return c.Desc }
 
+// CommandGuard returns the command guard.
+func (c SimpleCommand) CommandGuard() *Guard { return c.Guard }
+
+// CommandMenu returns the command menu.
+func (c SimpleCommand) CommandMenu() *Menu { return c.Menu }
+
+// CommandCooldown returns the command cooldown.
+func (c SimpleCommand) CommandCooldown() *Cooldown { return c.Cooldown }
+
 // Run runs command.
 func (c SimpleCommand) Run(m *tgot.Message, msg *tg.Message, args []string) {
 	if c.Func != nil {
@@ -55,11 +77,13 @@ func (c SimpleCommand) Run(m *tgot.Message, msg *tg.Message, args []string) {
 }
 
 // Is returns true if this command matches the given string.
-func (c SimpleCommand) Is(cmd string) bool {
-	if c.Command == cmd {
+func (c SimpleCommand) Is(cmd string) bool { return is(c.Command, c.Aliases, cmd) }
+
+func is(name string, aliases []string, cmd string) bool {
+	if name == cmd {
 		return true
 	}
-	for _, a := range c.Aliases {
+	for _, a := range aliases {
 		if a == cmd {
 			return true
 		}
@@ -68,27 +92,50 @@ func (c SimpleCommand) Is(cmd string) bool {
 }
 
 // Help generates help message.
-func (c SimpleCommand) Help() tgot.Text {
-	sb := strings.Builder{}
-	entities := make([]tg.MessageEntity, 2, 3)
-
-	// description
-	sb.WriteString(c.Command + " - " + c.Desc)
-	entities[0] = tg.MessageEntity{
-		Type:   tg.EntityBold,
-		Offset: 0,
-		Length: sb.Len(),
+func (c SimpleCommand) Help() tgot.Text { return c.helpFor(c.Command) }
+
+func (c SimpleCommand) helpFor(path string) tgot.Text {
+	return help(path, c.Desc, c.FullDesc, c.Args)
+}
+
+// pathHelper is implemented by the commands that can generate help message
+// for the full command path (e.g. 'admin ban').
+type pathHelper interface {
+	helpFor(path string) tgot.Text
+}
+
+func helpOf(c Command, path string) tgot.Text {
+	if h, ok := c.(pathHelper); ok {
+		return h.helpFor(path)
 	}
+	return c.Help()
+}
 
-	// usage
-	sb.WriteString("\n\nUsage:\n")
-	entities[1] = tg.MessageEntity{
-		Type:   tg.EntityCodeBlock,
-		Offset: sb.Len(),
+func help(name, desc, fullDesc string, args []Arg) tgot.Text {
+	return helpText(name, desc, fullDesc, args).Text()
+}
+
+func helpText(name, desc, fullDesc string, args []Arg) *tgot.TextBuilder {
+	b := tgot.NewTextBuilder().
+		Bold(name+" - "+desc).
+		Plain("\n\nUsage:\n").
+		Pre(usage(name, args), "")
+	if len(fullDesc) > 0 {
+		b.Plain("\n\n").Italic(fullDesc)
 	}
+	return b
+}
+
+func usage(name string, args []Arg) string {
+	var sb strings.Builder
+	writeUsage(&sb, name, args)
+	return sb.String()
+}
+
+func writeUsage(sb *strings.Builder, name string, args []Arg) {
 	sb.WriteByte(cmd.Prefix)
-	sb.WriteString(c.Command)
-	for _, a := range c.Args {
+	sb.WriteString(name)
+	for _, a := range args {
 		sb.WriteByte(' ')
 		if a.Required {
 			sb.WriteByte('[')
@@ -97,11 +144,16 @@ func (c SimpleCommand) Help() tgot.Text {
 		}
 		sb.WriteString(a.Name)
 
+		if a.Named {
+			sb.WriteByte('=')
+		}
 		if len(a.Consts) > 0 {
-			if len(a.Name) > 0 {
+			if len(a.Name) > 0 && !a.Named {
 				sb.WriteByte(':')
 			}
 			sb.WriteString("\"" + strings.Join(a.Consts, "\"|\"") + "\"")
+		} else if a.Named {
+			sb.WriteString("...")
 		}
 		if a.Required {
 			sb.WriteByte(']')
@@ -109,26 +161,10 @@ func (c SimpleCommand) Help() tgot.Text {
 			sb.WriteByte('}')
 		}
 	}
-	entities[1].Length = sb.Len() - entities[1].Offset
-
-	// full description
-	if len(c.FullDesc) > 0 {
-		sb.WriteString("\n\n")
-		entities = append(entities, tg.MessageEntity{
-			Type:   tg.EntityItalic,
-			Offset: sb.Len(),
-			Length: len(c.FullDesc),
-		})
-		sb.WriteString(c.FullDesc)
-	}
-
-	return tgot.Text{
-		Text:     sb.String(),
-		Entities: entities,
-	}
 }
 
 // MakeHelp creates '/help' command.
+// The '/help command subcommand ...' shows the help for the subcommand.
 func MakeHelp(list *List) SimpleCommand {
 	h := SimpleCommand{
 		Command: "help",
@@ -142,10 +178,11 @@ func MakeHelp(list *List) SimpleCommand {
 	h.Func = func(m *tgot.Message, msg *tg.Message, args []string) error {
 		chat := tgot.WithChatID(m, m.ID().Chat())
 		if len(args) > 0 {
-			if cmd := list.GetCmd(args[0]); cmd != nil {
-				return chat.ReplyE(tgot.ReplyTo(msg.ID), cmd.Help())
+			c, path, notFound := list.Resolve(args)
+			if notFound == "" {
+				return chat.ReplyE(tgot.ReplyTo(msg.ID), helpOf(c, path))
 			}
-			return chat.ReplyE(tgot.ReplyTo(msg.ID), tgot.NewText("command not found"))
+			return chat.ReplyE(tgot.ReplyTo(msg.ID), tgot.NewText(notFoundText(c, path, notFound)))
 		}
 		var sb strings.Builder
 		sb.WriteString("Commands list\n")
@@ -159,3 +196,17 @@ func MakeHelp(list *List) SimpleCommand {
 	}
 	return h
 }
+
+func notFoundText(parent Command, path, name string) string {
+	var list List
+	text := "command not found"
+	if parent != nil {
+		list = parent.(Parent).Subcommands()
+		text = "subcommand not found"
+		path += " "
+	}
+	if s := list.Suggest(name); s != "" {
+		text += ", did you mean " + string(cmd.Prefix) + path + s + "?"
+	}
+	return text
+}
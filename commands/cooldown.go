@@ -0,0 +1,256 @@
+package commands
+
+import (
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/karalef/tgot"
+	"github.com/karalef/tgot/api/tg"
+)
+
+// Throttled is implemented by the commands that have a cooldown.
+type Throttled interface {
+	CommandCooldown() *Cooldown
+}
+
+// Limit allows Burst runs within the Window.
+// The runs are restored evenly, one per Window/Burst.
+type Limit struct {
+	// Window is the cooldown duration. Zero disables the limit.
+	Window time.Duration
+
+	// Burst is the number of runs allowed within the window.
+	// Default is 1.
+	Burst int
+}
+
+func (l Limit) interval() time.Duration {
+	if l.Burst > 1 {
+		return l.Window / time.Duration(l.Burst)
+	}
+	return l.Window
+}
+
+// Cooldown limits how often the command can be run.
+// All the specified limits must be satisfied. The run is counted only if it
+// is allowed by all limits.
+type Cooldown struct {
+	PerUser Limit
+	PerChat Limit
+	Global  Limit
+
+	// ExemptAdmins exempts the chat administrators from the cooldown.
+	// It requests the chat member for each run in groups unless it is cached
+	// in Members.
+	ExemptAdmins bool
+
+	// Members caches the chat members needed to detect the administrators.
+	Members *MemberCache
+
+	// Store stores the cooldown state.
+	// If it is nil, the state is stored in memory separately for each
+	// cooldown. The keys are prefixed only with the command name, so a store
+	// shared by several cooldowns must not be used for same-named commands.
+	Store CooldownStore
+
+	// Feedback is called when the command is on cooldown.
+	// If it is nil, ReplyRetry is used.
+	Feedback CooldownFeedback
+
+	// OnError is called when the store or the administrator check fails.
+	// The command is allowed if the store fails and the user is considered
+	// not an administrator if the check fails.
+	OnError func(m *tgot.Message, msg *tg.Message, err error)
+
+	mut sync.Mutex
+	mem *MemoryCooldownStore
+}
+
+// CooldownFeedback is called when the command is on cooldown.
+// The retry is the duration after which the command can be run again.
+type CooldownFeedback func(m *tgot.Message, msg *tg.Message, retry time.Duration)
+
+// ReplyRetry replies with the time after which the command can be run again.
+func ReplyRetry(m *tgot.Message, _ *tg.Message, retry time.Duration) {
+	m.ReplyText("try again in " + (retry + time.Second - 1).Truncate(time.Second).String())
+}
+
+// React returns the feedback that reacts to the message with the emoji.
+func React(emoji string) CooldownFeedback {
+	return func(m *tgot.Message, _ *tg.Message, _ time.Duration) {
+		m.SetReaction([]tg.ReactionType{{Value: tg.ReactionTypeEmoji{Emoji: emoji}}})
+	}
+}
+
+// Silent ignores the message.
+func Silent(*tgot.Message, *tg.Message, time.Duration) {}
+
+// CooldownStore stores the cooldown state.
+// The state of each key is the time when the limit is fully restored.
+// The implementation must be safe for concurrent use.
+type CooldownStore interface {
+	// Load returns the state of the key or the zero time if there is none.
+	Load(key string) (time.Time, error)
+
+	// Store stores the state of the key. The state can be deleted after the
+	// ttl expires.
+	Store(key string, t time.Time, ttl time.Duration) error
+}
+
+// Allow checks the limits and counts the run if it is allowed.
+// If it is not allowed, it returns the duration after which the run will be
+// allowed.
+func (c *Cooldown) Allow(name string, msg *tg.Message) (time.Duration, error) {
+	return c.allow(name, msg, time.Now())
+}
+
+func (c *Cooldown) allow(name string, msg *tg.Message, now time.Time) (time.Duration, error) {
+	type check struct {
+		key   string
+		limit Limit
+		tat   time.Time
+	}
+	checks := make([]check, 0, 3)
+	if c.PerUser.Window > 0 && msg.From != nil {
+		checks = append(checks, check{key: name + ":user:" + strconv.FormatInt(int64(msg.From.ID), 10), limit: c.PerUser})
+	}
+	if c.PerChat.Window > 0 {
+		checks = append(checks, check{key: name + ":chat:" + strconv.FormatInt(int64(msg.Chat.ID), 10), limit: c.PerChat})
+	}
+	if c.Global.Window > 0 {
+		checks = append(checks, check{key: name + ":global", limit: c.Global})
+	}
+
+	c.mut.Lock()
+	defer c.mut.Unlock()
+
+	store := c.Store
+	if store == nil {
+		if c.mem == nil {
+			c.mem = NewMemoryCooldownStore()
+		}
+		store = c.mem
+	}
+
+	// generic cell rate algorithm: tat is the theoretical arrival time.
+	var retry time.Duration
+	for i := range checks {
+		tat, err := store.Load(checks[i].key)
+		if err != nil {
+			return 0, err
+		}
+		if tat.Before(now) {
+			tat = now
+		}
+		interval := checks[i].limit.interval()
+		if wait := tat.Sub(now) - (checks[i].limit.Window - interval); wait > retry {
+			retry = wait
+		}
+		checks[i].tat = tat.Add(interval)
+	}
+	if retry > 0 {
+		return retry, nil
+	}
+	for _, ch := range checks {
+		if err := store.Store(ch.key, ch.tat, ch.tat.Sub(now)); err != nil {
+			return 0, err
+		}
+	}
+	return 0, nil
+}
+
+// check checks the cooldown and calls the feedback if the command is on
+// cooldown. The administrators' runs are not counted if ExemptAdmins is set.
+func (c *Cooldown) check(m *tgot.Message, msg *tg.Message, name string) bool {
+	if c.ExemptAdmins {
+		admin, err := isAdmin(m, msg, c.Members)
+		if err != nil {
+			c.onError(m, msg, err)
+		}
+		if admin {
+			return true
+		}
+	}
+	retry, err := c.Allow(name, msg)
+	if err != nil {
+		c.onError(m, msg, err)
+		return true
+	}
+	if retry <= 0 {
+		return true
+	}
+	if c.Feedback != nil {
+		c.Feedback(m, msg, retry)
+	} else {
+		ReplyRetry(m, msg, retry)
+	}
+	return false
+}
+
+func (c *Cooldown) onError(m *tgot.Message, msg *tg.Message, err error) {
+	if c.OnError != nil {
+		c.OnError(m, msg, err)
+	}
+}
+
+func isAdmin(m *tgot.Message, msg *tg.Message, members *MemberCache) (bool, error) {
+	if msg.Chat.Type == tg.ChatPrivate {
+		return false, nil
+	}
+	if msg.SenderChat != nil && msg.SenderChat.ID == msg.Chat.ID {
+		return true, nil
+	}
+	if msg.From == nil {
+		return false, nil
+	}
+	member, err := members.get(m, msg.Chat.ID, msg.From.ID, DefaultMemberCacheTTL)
+	if err != nil {
+		return false, err
+	}
+	status := member.Status()
+	return status == tg.ChatMemberStatusOwner || status == tg.ChatMemberStatusAdmin, nil
+}
+
+// NewMemoryCooldownStore creates a new in-memory cooldown store.
+func NewMemoryCooldownStore() *MemoryCooldownStore {
+	return &MemoryCooldownStore{entries: make(map[string]cooldownEntry)}
+}
+
+// MemoryCooldownStore is an in-memory CooldownStore.
+type MemoryCooldownStore struct {
+	mut     sync.Mutex
+	entries map[string]cooldownEntry
+}
+
+type cooldownEntry struct {
+	t       time.Time
+	expires time.Time
+}
+
+// maxCooldownEntries is the number of entries after which the expired ones
+// are removed.
+const maxCooldownEntries = 1024
+
+// Load implements CooldownStore.
+func (s *MemoryCooldownStore) Load(key string) (time.Time, error) {
+	s.mut.Lock()
+	defer s.mut.Unlock()
+	return s.entries[key].t, nil
+}
+
+// Store implements CooldownStore.
+func (s *MemoryCooldownStore) Store(key string, t time.Time, ttl time.Duration) error {
+	now := time.Now()
+	s.mut.Lock()
+	defer s.mut.Unlock()
+	if len(s.entries) >= maxCooldownEntries {
+		for k, e := range s.entries {
+			if !e.expires.After(now) {
+				delete(s.entries, k)
+			}
+		}
+	}
+	s.entries[key] = cooldownEntry{t, now.Add(ttl)}
+	return nil
+}
@@ -0,0 +1,109 @@
+package commands
+
+import (
+	"testing"
+	"time"
+
+	"github.com/karalef/tgot"
+	"github.com/karalef/tgot/api/tg"
+)
+
+func TestCooldown(t *testing.T) {
+	c := &Cooldown{
+		PerUser: Limit{Window: time.Minute, Burst: 2},
+		Global:  Limit{Window: time.Second, Burst: 3},
+		Store:   NewMemoryCooldownStore(),
+	}
+	msg := &tg.Message{From: &tg.User{ID: 1}, Chat: &tg.Chat{ID: 1}}
+	now := time.Now()
+	allow := func(at time.Duration) time.Duration {
+		retry, err := c.allow("cmd", msg, now.Add(at))
+		if err != nil {
+			t.Fatal(err)
+		}
+		return retry
+	}
+
+	if allow(0) != 0 || allow(0) != 0 {
+		t.Fatal("burst is not allowed")
+	}
+	if retry := allow(time.Second); retry != 29*time.Second {
+		t.Fatalf("expected retry 29s, got %s", retry)
+	}
+	if allow(30*time.Second) != 0 {
+		t.Fatal("restored run is not allowed")
+	}
+
+	// the global limit is shared by all users
+	for id := range tg.ID(3) {
+		msg := &tg.Message{From: &tg.User{ID: id + 10}, Chat: &tg.Chat{ID: 1}}
+		if retry, _ := c.allow("cmd", msg, now.Add(time.Hour)); retry != 0 {
+			t.Fatal("run is not allowed")
+		}
+	}
+	if allow(time.Hour) == 0 {
+		t.Fatal("global limit is not applied")
+	}
+}
+
+func TestCooldownPerChat(t *testing.T) {
+	c := &Cooldown{PerChat: Limit{Window: time.Minute}}
+	now := time.Now()
+	chat := &tg.Chat{ID: 1}
+	if retry, _ := c.allow("cmd", &tg.Message{From: &tg.User{ID: 1}, Chat: chat}, now); retry != 0 {
+		t.Fatal("first run is not allowed")
+	}
+	if retry, _ := c.allow("cmd", &tg.Message{From: &tg.User{ID: 2}, Chat: chat}, now); retry != time.Minute {
+		t.Fatalf("expected retry 1m for another user in the chat, got %s", retry)
+	}
+	other := &tg.Message{From: &tg.User{ID: 1}, Chat: &tg.Chat{ID: 2}}
+	if retry, _ := c.allow("cmd", other, now); retry != 0 {
+		t.Fatal("chat limit is applied to another chat")
+	}
+	if retry, _ := c.allow("other", other, now); retry != 0 {
+		t.Fatal("chat limit is applied to another command")
+	}
+}
+
+func TestCooldownAdmins(t *testing.T) {
+	var rejected int
+	c := &Cooldown{
+		PerChat:      Limit{Window: time.Minute},
+		ExemptAdmins: true,
+		Feedback:     func(*tgot.Message, *tg.Message, time.Duration) { rejected++ },
+		OnError:      func(_ *tgot.Message, _ *tg.Message, err error) { t.Fatal(err) },
+	}
+	chat := &tg.Chat{ID: -1, Type: tg.ChatSuperGroup}
+	// an anonymous administrator sends messages on behalf of the chat.
+	admin := &tg.Message{SenderChat: chat, Chat: chat}
+	user := &tg.Message{From: &tg.User{ID: 1}, Chat: chat}
+	c.Members = &MemberCache{entries: map[memberKey]cachedMember{}}
+	c.Members.entries[memberKey{chat.ID, 1}] = cachedMember{
+		member:  &tg.ChatMember{User: *user.From, Member: tg.ChatMemberMember{}},
+		at:      time.Now(),
+		expires: time.Now().Add(time.Minute),
+	}
+
+	for range 3 {
+		if !c.check(nil, admin, "cmd") {
+			t.Fatal("admin is not exempt")
+		}
+	}
+	if !c.check(nil, user, "cmd") {
+		t.Fatal("admin runs consumed the chat budget")
+	}
+	if c.check(nil, user, "cmd") || rejected != 1 {
+		t.Fatal("chat limit is not applied")
+	}
+
+	c.ExemptAdmins = false
+	if c.check(nil, admin, "cmd") {
+		t.Fatal("limit is not applied to admin")
+	}
+
+	// the member is not requested unless the admins are exempt
+	d := &Cooldown{PerChat: Limit{Window: time.Minute}}
+	if !d.check(nil, &tg.Message{From: &tg.User{ID: 2}, Chat: chat}, "cmd") {
+		t.Fatal("first run is not allowed")
+	}
+}
@@ -26,12 +26,16 @@ type Filter struct {
 	// Command is called when the message is a command for the current bot.
 	// The ctx is a child of original context with name 'commands'.
 	Command func(m *tgot.Message, msg *tg.Message, cmd string, args []string)
+
+	// Tokens is like Command but receives the arguments with their entities
+	// (see List.Tokens). If it is set, Command is not called.
+	Tokens func(m *tgot.Message, msg *tg.Message, cmd string, tokens []cmd.Token)
 }
 
 // Handle handles message.
 func (h *Filter) Handle(ctx *tgot.Message, msg *tg.Message) {
 	cmd := cmd.ParseMsg(msg)
-	if cmd.Name == "" || h.Command == nil {
+	if cmd.Name == "" || h.Command == nil && h.Tokens == nil {
 		if h.Message != nil {
 			h.Message(ctx, msg)
 		}
@@ -48,5 +52,9 @@ func (h *Filter) Handle(ctx *tgot.Message, msg *tg.Message) {
 			return
 		}
 	}
+	if h.Tokens != nil {
+		h.Tokens(ctx.WithName("commands"), msg, cmd.Name, cmd.Tokens)
+		return
+	}
 	h.Command(ctx.WithName("commands"), msg, cmd.Name, cmd.Args)
 }
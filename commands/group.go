@@ -0,0 +1,108 @@
+package commands
+
+import (
+	"strings"
+
+	"github.com/karalef/tgot"
+	"github.com/karalef/tgot/api/cmd"
+	"github.com/karalef/tgot/api/tg"
+)
+
+// Parent represents command with subcommands.
+type Parent interface {
+	Command
+	Subcommands() List
+}
+
+var _ Parent = Group{}
+
+// Group represents command with subcommands (e.g. '/admin ban').
+// The first argument selects the subcommand which receives the rest of the
+// arguments. Subcommands can be groups too.
+type Group struct {
+	Command  string
+	Aliases  []string
+	Commands List
+
+	// Func is called if there are no arguments.
+	// If it is nil, the help message is replied.
+	Func func(*tgot.Message, *tg.Message) error
+
+	Desc     string
+	FullDesc string
+
+	// Guard restricts who can run the command and all its subcommands.
+	Guard *Guard
+
+	// Menu declares the scopes and localized descriptions of the command
+	// in the bot's menu (see List.SyncMenus).
+	Menu *Menu
+
+	// Cooldown limits how often the command can be run.
+	Cooldown *Cooldown
+}
+
+// Name returns command name.
+func (g Group) Name() string { return g.Command }
+
+// Description returns command description.
+func (g Group) Description() string { return g.Desc }
+
+// CommandGuard returns the command guard.
+func (g Group) CommandGuard() *Guard { return g.Guard }
+
+// CommandMenu returns the command menu.
+func (g Group) CommandMenu() *Menu { return g.Menu }
+
+// CommandCooldown returns the command cooldown.
+func (g Group) CommandCooldown() *Cooldown { return g.Cooldown }
+
+// Subcommands returns the list of subcommands.
+func (g Group) Subcommands() List { return g.Commands }
+
+// Is returns true if this command matches the given string.
+func (g Group) Is(cmd string) bool { return is(g.Command, g.Aliases, cmd) }
+
+// Run runs the subcommand.
+func (g Group) Run(m *tgot.Message, msg *tg.Message, args []string) {
+	g.runTokens(m, msg, g.Command, plainTokens(args))
+}
+
+func (g Group) runTokens(m *tgot.Message, msg *tg.Message, path string, tokens []cmd.Token) {
+	if len(tokens) == 0 {
+		if g.Func != nil {
+			g.Func(m, msg)
+		} else {
+			m.Reply(g.helpFor(path))
+		}
+		return
+	}
+	name := tokens[0].Value
+	sub := g.Commands.GetCmd(name)
+	if sub == nil {
+		m.Reply(tgot.NewText(notFoundText(g, path, name)))
+		return
+	}
+	run(sub, m, msg, path+" "+name, tokens[1:])
+}
+
+// Help generates help message.
+func (g Group) Help() tgot.Text { return g.helpFor(g.Command) }
+
+func (g Group) helpFor(path string) tgot.Text {
+	var sb strings.Builder
+	sb.WriteString("\n\nSubcommands:")
+	writeTree(&sb, g.Commands, "\n")
+	return helpText(path, g.Desc, g.FullDesc, []Arg{{Name: "subcommand"}}).
+		Plain(sb.String()).
+		Text()
+}
+
+func writeTree(sb *strings.Builder, list List, indent string) {
+	for _, c := range list {
+		sb.WriteString(indent + c.Name() + " - " + c.Description())
+		if p, ok := c.(Parent); ok {
+			writeTree(sb, p.Subcommands(), indent+"  ")
+		}
+	}
+}
@@ -0,0 +1,247 @@
+package commands
+
+import (
+	"slices"
+	"sync"
+	"time"
+
+	"github.com/karalef/tgot"
+	"github.com/karalef/tgot/api/cmd"
+	"github.com/karalef/tgot/api/tg"
+)
+
+// DefaultMemberCacheTTL is the default lifetime of the cached chat members.
+const DefaultMemberCacheTTL = time.Minute
+
+// Guarded is implemented by the commands that have a guard.
+type Guarded interface {
+	CommandGuard() *Guard
+}
+
+// Guard restricts who can run the command.
+// All the specified restrictions must be satisfied.
+type Guard struct {
+	// ChatTypes contains allowed chat types. Empty means any.
+	ChatTypes []tg.ChatType
+
+	// PrivateOnly allows the command only in private chats.
+	PrivateOnly bool
+
+	// Users contains allowed user ids. Empty means any.
+	Users []tg.ID
+
+	// Statuses contains allowed member statuses. Empty means any.
+	Statuses []tg.ChatMemberStatus
+
+	// Rights contains the administrator rights the user must have.
+	// The chat owner has all rights.
+	// Messages sent by anonymous administrators on behalf of the chat are
+	// considered to be sent by an administrator without any rights.
+	Rights *tg.ChatAdministratorRights
+
+	// Members caches the chat members needed for Statuses and Rights.
+	// If it is nil, the member is requested for each run.
+	Members *MemberCache
+
+	// CacheTTL specifies how long the chat member information is cached in
+	// Members. Default is DefaultMemberCacheTTL.
+	CacheTTL time.Duration
+
+	// Reject is called when the user is not allowed to run the command.
+	// The err is not nil if the check itself failed.
+	// If it is nil, DefaultReject is used.
+	Reject func(m *tgot.Message, msg *tg.Message, err error)
+}
+
+// DefaultReject replies that the command is not allowed.
+func DefaultReject(m *tgot.Message, _ *tg.Message, _ error) {
+	m.ReplyText("you are not allowed to use this command")
+}
+
+// Allowed checks if the message sender is allowed to run the command.
+func (g *Guard) Allowed(m *tgot.Message, msg *tg.Message) (bool, error) {
+	if g.PrivateOnly && msg.Chat.Type != tg.ChatPrivate {
+		return false, nil
+	}
+	if len(g.ChatTypes) > 0 && !slices.Contains(g.ChatTypes, msg.Chat.Type) {
+		return false, nil
+	}
+	if len(g.Users) > 0 && (msg.From == nil || !slices.Contains(g.Users, msg.From.ID)) {
+		return false, nil
+	}
+	if len(g.Statuses) == 0 && g.Rights == nil {
+		return true, nil
+	}
+
+	var member *tg.ChatMember
+	if msg.SenderChat != nil && msg.SenderChat.ID == msg.Chat.ID {
+		member = &tg.ChatMember{Member: tg.ChatMemberAdministrator{}}
+	} else if msg.From != nil {
+		var err error
+		member, err = g.Members.get(m, msg.Chat.ID, msg.From.ID, g.cacheTTL())
+		if err != nil {
+			return false, err
+		}
+	} else {
+		return false, nil
+	}
+
+	status := member.Status()
+	if len(g.Statuses) > 0 && !slices.Contains(g.Statuses, status) {
+		return false, nil
+	}
+	if g.Rights == nil || status == tg.ChatMemberStatusOwner {
+		return true, nil
+	}
+	admin, ok := member.Member.(tg.ChatMemberAdministrator)
+	return ok && hasRights(admin.ChatAdministratorRights, *g.Rights), nil
+}
+
+func (g *Guard) cacheTTL() time.Duration {
+	if g.CacheTTL > 0 {
+		return g.CacheTTL
+	}
+	return DefaultMemberCacheTTL
+}
+
+func (g *Guard) check(m *tgot.Message, msg *tg.Message) bool {
+	ok, err := g.Allowed(m, msg)
+	if ok {
+		return true
+	}
+	if g.Reject != nil {
+		g.Reject(m, msg, err)
+	} else {
+		DefaultReject(m, msg, err)
+	}
+	return false
+}
+
+// hasRights reports whether the rights include all the required ones.
+func hasRights(rights, required tg.ChatAdministratorRights) bool {
+	for _, r := range [...][2]bool{
+		{rights.IsAnonymous, required.IsAnonymous},
+		{rights.CanManageChat, required.CanManageChat},
+		{rights.CanDeleteMessages, required.CanDeleteMessages},
+		{rights.CanManageVideoChats, required.CanManageVideoChats},
+		{rights.CanRestrictMembers, required.CanRestrictMembers},
+		{rights.CanPromoteMembers, required.CanPromoteMembers},
+		{rights.CanChangeInfo, required.CanChangeInfo},
+		{rights.CanInviteUsers, required.CanInviteUsers},
+		{rights.CanPostStories, required.CanPostStories},
+		{rights.CanEditStories, required.CanEditStories},
+		{rights.CanDeleteStories, required.CanDeleteStories},
+		{rights.CanPostMessages, required.CanPostMessages},
+		{rights.CanEditMessages, required.CanEditMessages},
+		{rights.CanPinMessages, required.CanPinMessages},
+		{rights.CanManageTopics, required.CanManageTopics},
+	} {
+		if r[1] && !r[0] {
+			return false
+		}
+	}
+	return true
+}
+
+// tokensRunner is implemented by the commands that use the tokens of the
+// arguments instead of their values.
+// The path is the command path typed by the user (e.g. 'admin ban').
+type tokensRunner interface {
+	runTokens(m *tgot.Message, msg *tg.Message, path string, tokens []cmd.Token)
+}
+
+// run checks the command guard and cooldown and runs the command.
+func run(c Command, m *tgot.Message, msg *tg.Message, path string, tokens []cmd.Token) {
+	if g, ok := c.(Guarded); ok {
+		if guard := g.CommandGuard(); guard != nil && !guard.check(m, msg) {
+			return
+		}
+	}
+	if t, ok := c.(Throttled); ok {
+		if cd := t.CommandCooldown(); cd != nil && !cd.check(m, msg, c.Name()) {
+			return
+		}
+	}
+	m = m.WithName(c.Name())
+	if r, ok := c.(tokensRunner); ok {
+		r.runTokens(m, msg, path, tokens)
+		return
+	}
+	c.Run(m, msg, tokenValues(tokens))
+}
+
+type memberKey struct {
+	chat tg.ID
+	user tg.ID
+}
+
+type cachedMember struct {
+	member  *tg.ChatMember
+	at      time.Time
+	expires time.Time
+}
+
+// MemberCache caches the results of getChatMember by chat and user.
+// It must be used only with one bot. The cache can be shared by the guards
+// and cooldowns of the commands.
+//
+// The zero value is ready to use.
+type MemberCache struct {
+	mut     sync.Mutex
+	entries map[memberKey]cachedMember
+}
+
+// maxCachedMembers is the maximum number of entries. When it is reached, the
+// expired entries are removed and, if there are none, the entry that expires
+// first.
+const maxCachedMembers = 1024
+
+// get returns the cached member or requests it. The nil cache always
+// requests the member.
+func (c *MemberCache) get(ctx tgot.BaseContext, chat, user tg.ID, ttl time.Duration) (*tg.ChatMember, error) {
+	if c == nil {
+		return tgot.WithChatMember(ctx, tgot.NewChatID(chat), user).Get()
+	}
+	key := memberKey{chat, user}
+	now := time.Now()
+	c.mut.Lock()
+	e, ok := c.entries[key]
+	c.mut.Unlock()
+	if ok && now.Sub(e.at) < ttl {
+		return e.member, nil
+	}
+
+	member, err := tgot.WithChatMember(ctx, tgot.NewChatID(chat), user).Get()
+	if err != nil {
+		return nil, err
+	}
+
+	c.mut.Lock()
+	if c.entries == nil {
+		c.entries = make(map[memberKey]cachedMember)
+	}
+	if _, ok := c.entries[key]; !ok && len(c.entries) >= maxCachedMembers {
+		c.evict(now)
+	}
+	c.entries[key] = cachedMember{member, now, now.Add(ttl)}
+	c.mut.Unlock()
+	return member, nil
+}
+
+// evict removes the expired entries or the entry that expires first.
+func (c *MemberCache) evict(now time.Time) {
+	var (
+		first   memberKey
+		expires time.Time
+	)
+	for k, e := range c.entries {
+		if !e.expires.After(now) {
+			delete(c.entries, k)
+		} else if expires.IsZero() || e.expires.Before(expires) {
+			first, expires = k, e.expires
+		}
+	}
+	if len(c.entries) >= maxCachedMembers {
+		delete(c.entries, first)
+	}
+}
@@ -0,0 +1,63 @@
+package commands
+
+import (
+	"testing"
+	"time"
+
+	"github.com/karalef/tgot/api/tg"
+)
+
+func TestGuardAllowed(t *testing.T) {
+	private := &tg.Message{Chat: &tg.Chat{ID: 1, Type: tg.ChatPrivate}, From: &tg.User{ID: 1}}
+	group := &tg.Message{Chat: &tg.Chat{ID: -1, Type: tg.ChatGroup}, From: &tg.User{ID: 2}}
+
+	tests := []struct {
+		guard    Guard
+		msg      *tg.Message
+		expected bool
+	}{
+		{Guard{PrivateOnly: true}, private, true},
+		{Guard{PrivateOnly: true}, group, false},
+		{Guard{ChatTypes: []tg.ChatType{tg.ChatGroup, tg.ChatSuperGroup}}, group, true},
+		{Guard{ChatTypes: []tg.ChatType{tg.ChatSuperGroup}}, group, false},
+		{Guard{Users: []tg.ID{2}}, group, true},
+		{Guard{Users: []tg.ID{2}}, private, false},
+	}
+	for i, test := range tests {
+		ok, err := test.guard.Allowed(nil, test.msg)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if ok != test.expected {
+			t.Errorf("%d: expected %v, got %v", i, test.expected, ok)
+		}
+	}
+}
+
+func TestHasRights(t *testing.T) {
+	rights := tg.ChatAdministratorRights{CanDeleteMessages: true, CanRestrictMembers: true}
+	if !hasRights(rights, tg.ChatAdministratorRights{CanRestrictMembers: true}) {
+		t.Fatal("expected rights to be satisfied")
+	}
+	if hasRights(rights, tg.ChatAdministratorRights{CanPromoteMembers: true}) {
+		t.Fatal("expected rights to be unsatisfied")
+	}
+}
+
+func TestMemberCacheEvict(t *testing.T) {
+	now := time.Now()
+	var c MemberCache
+	c.entries = make(map[memberKey]cachedMember)
+	for i := range maxCachedMembers {
+		c.entries[memberKey{1, tg.ID(i)}] = cachedMember{expires: now.Add(time.Duration(i+1) * time.Second)}
+	}
+
+	c.evict(now)
+	if _, ok := c.entries[memberKey{1, 0}]; ok || len(c.entries) != maxCachedMembers-1 {
+		t.Fatalf("the entry that expires first is not evicted, %d entries", len(c.entries))
+	}
+	c.evict(now.Add(100 * time.Second))
+	if len(c.entries) != maxCachedMembers-100 {
+		t.Fatalf("expected the expired entries to be evicted, %d entries", len(c.entries))
+	}
+}
@@ -1,15 +1,22 @@
 package commands
 
 import (
+	"fmt"
+
 	"github.com/karalef/tgot"
+	"github.com/karalef/tgot/api/cmd"
 	"github.com/karalef/tgot/api/tg"
 )
 
 // List represents simple commands list.
 type List []Command
 
-// Setup sets the default list of the bot's commands on Telegram servers.
+// Setup validates the commands and sets the default list of the bot's
+// commands on Telegram servers.
 func (list List) Setup(b *tgot.Bot) error {
+	if err := list.Validate(); err != nil {
+		return err
+	}
 	cmds := make([]tg.Command, len(list))
 	for i := range list {
 		cmds[i] = tg.Command{
@@ -17,14 +24,50 @@ func (list List) Setup(b *tgot.Bot) error {
 			Description: list[i].Description(),
 		}
 	}
-	return b.SetCommands(&tgot.CommandsData{Commands: cmds})
+	return b.SetCommands(&tgot.CommandsData{
+		Commands: cmds,
+		Scope:    tg.CommandScopeDefault(),
+	})
+}
+
+// Validator is implemented by the commands that can check their declaration
+// (e.g. TypedCommand).
+type Validator interface {
+	Validate() error
+}
+
+// Validate validates the commands and their subcommands, so the invalid
+// declarations are found when the bot starts instead of when the command is
+// run.
+func (list List) Validate() error {
+	for _, c := range list {
+		if v, ok := c.(Validator); ok {
+			if err := v.Validate(); err != nil {
+				return fmt.Errorf("command %s: %w", c.Name(), err)
+			}
+		}
+		if p, ok := c.(Parent); ok {
+			if err := p.Subcommands().Validate(); err != nil {
+				return fmt.Errorf("command %s: %w", c.Name(), err)
+			}
+		}
+	}
+	return nil
 }
 
 // Command runs a command if it exists.
+// The args have no entities, so the typed commands can not resolve the text
+// mentions. Use Tokens to keep them.
 func (list List) Command(m *tgot.Message, msg *tg.Message, cmd string, args []string) {
-	c := list.GetCmd(cmd)
+	list.Tokens(m, msg, cmd, plainTokens(args))
+}
+
+// Tokens runs a command if it exists using the tokens of the arguments
+// (see Filter.Tokens).
+func (list List) Tokens(m *tgot.Message, msg *tg.Message, name string, tokens []cmd.Token) {
+	c := list.GetCmd(name)
 	if c != nil {
-		c.Run(m.WithName(c.Name()), msg, args)
+		run(c, m, msg, name, tokens)
 	}
 }
 
@@ -33,6 +76,68 @@ func (list List) Exists(cmd string) bool {
 	return list.GetCmd(cmd) != nil
 }
 
+// Resolve resolves the command path (e.g. ["admin", "ban"]) through the
+// subcommands. It returns the deepest found command and its path joined by
+// spaces. If a command or subcommand is not found, it is returned as notFound
+// and the found command is its parent (nil for the top level).
+// The path stops at the first command that has no subcommands.
+func (list List) Resolve(path []string) (c Command, name string, notFound string) {
+	cur := list
+	for i, p := range path {
+		sub := cur.GetCmd(p)
+		if sub == nil {
+			return c, name, p
+		}
+		c = sub
+		if i == 0 {
+			name = sub.Name()
+		} else {
+			name += " " + sub.Name()
+		}
+		parent, ok := sub.(Parent)
+		if !ok {
+			break
+		}
+		cur = parent.Subcommands()
+	}
+	return c, name, ""
+}
+
+// Suggest returns the name of the command that is the closest to
+// the given one by edit distance. It returns an empty string if there is no
+// similar command.
+func (list List) Suggest(cmd string) string {
+	best, dist := "", len(cmd)/2+1
+	for _, c := range list {
+		if d := editDistance(cmd, c.Name()); d < dist {
+			best, dist = c.Name(), d
+		}
+	}
+	return best
+}
+
+// editDistance returns the Levenshtein distance between a and b.
+func editDistance(a, b string) int {
+	ra, rb := []rune(a), []rune(b)
+	prev := make([]int, len(rb)+1)
+	cur := make([]int, len(rb)+1)
+	for j := range prev {
+		prev[j] = j
+	}
+	for i := 1; i <= len(ra); i++ {
+		cur[0] = i
+		for j := 1; j <= len(rb); j++ {
+			cost := 1
+			if ra[i-1] == rb[j-1] {
+				cost = 0
+			}
+			cur[j] = min(prev[j]+1, cur[j-1]+1, prev[j-1]+cost)
+		}
+		prev, cur = cur, prev
+	}
+	return prev[len(rb)]
+}
+
 // GetCmd returns command by name.
 func (list List) GetCmd(cmd string) Command {
 	for _, c := range list {
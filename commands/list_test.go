@@ -0,0 +1,39 @@
+package commands
+
+import "testing"
+
+func TestResolve(t *testing.T) {
+	list := List{
+		Group{
+			Command: "admin",
+			Commands: List{
+				SimpleCommand{Command: "ban"},
+				Group{
+					Command:  "mute",
+					Commands: List{SimpleCommand{Command: "list"}},
+				},
+			},
+		},
+		SimpleCommand{Command: "start"},
+	}
+
+	c, path, notFound := list.Resolve([]string{"admin", "mute", "list", "extra"})
+	if c == nil || c.Name() != "list" || path != "admin mute list" || notFound != "" {
+		t.Fatalf("unexpected result %v %q %q", c, path, notFound)
+	}
+
+	c, path, notFound = list.Resolve([]string{"admin", "bam"})
+	if c == nil || c.Name() != "admin" || path != "admin" || notFound != "bam" {
+		t.Fatalf("unexpected result %v %q %q", c, path, notFound)
+	}
+	if text := notFoundText(c, path, notFound); text != "subcommand not found, did you mean /admin ban?" {
+		t.Fatalf("unexpected text %q", text)
+	}
+
+	if s := list.Suggest("strat"); s != "start" {
+		t.Fatalf("expected start, got %q", s)
+	}
+	if s := list.Suggest("completely"); s != "" {
+		t.Fatalf("unexpected suggestion %q", s)
+	}
+}
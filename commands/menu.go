@@ -0,0 +1,224 @@
+package commands
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/karalef/tgot"
+	"github.com/karalef/tgot/api/tg"
+)
+
+// Menu declares where and how the command appears in the bot's menu.
+type Menu struct {
+	// Scopes contains the scopes in which the command appears.
+	// If empty, the default scope is used.
+	Scopes []tg.CommandScope
+
+	// Descriptions contains the localized descriptions by language code.
+	// The command description is used for other languages.
+	Descriptions map[string]string
+
+	// Hidden excludes the command from the menu.
+	Hidden bool
+}
+
+// MenuCommand is implemented by the commands that declare the menu.
+type MenuCommand interface {
+	CommandMenu() *Menu
+}
+
+// MenuKey identifies the list of commands by scope and language.
+type MenuKey struct {
+	Scope tg.CommandScope
+	Lang  string
+}
+
+func (k MenuKey) String() string {
+	s := k.Scope.Type
+	if k.Scope.ChatID != nil {
+		s += fmt.Sprintf(" %v", k.Scope.ChatID)
+	}
+	if k.Scope.UserID != 0 {
+		s += fmt.Sprintf(" user %d", k.Scope.UserID)
+	}
+	if k.Lang != "" {
+		s += " [" + k.Lang + "]"
+	}
+	return s
+}
+
+// Menus returns the lists of commands for each scope and language.
+// The localized list is omitted if it is equal to the list without language
+// in the same scope since Telegram falls back to it.
+func (list List) Menus() map[MenuKey][]tg.Command {
+	menus, _ := list.menus()
+	return menus
+}
+
+// menus returns the menus and the keys of all the declared scopes and
+// languages including the omitted localized lists.
+func (list List) menus() (map[MenuKey][]tg.Command, []MenuKey) {
+	var (
+		scopes []tg.CommandScope
+		langs  = []string{""}
+	)
+	for _, c := range list {
+		m := menuOf(c)
+		if m.Hidden {
+			continue
+		}
+		for _, s := range m.scopes() {
+			if !slices.Contains(scopes, s) {
+				scopes = append(scopes, s)
+			}
+		}
+		for l := range m.Descriptions {
+			if l != "" && !slices.Contains(langs, l) {
+				langs = append(langs, l)
+			}
+		}
+	}
+
+	menus := make(map[MenuKey][]tg.Command)
+	keys := make([]MenuKey, 0, len(scopes)*len(langs))
+	for _, s := range scopes {
+		for _, l := range langs {
+			keys = append(keys, MenuKey{s, l})
+			var cmds []tg.Command
+			for _, c := range list {
+				m := menuOf(c)
+				if m.Hidden || !slices.Contains(m.scopes(), s) {
+					continue
+				}
+				desc := c.Description()
+				if d, ok := m.Descriptions[l]; ok && l != "" {
+					desc = d
+				}
+				cmds = append(cmds, tg.Command{Command: c.Name(), Description: desc})
+			}
+			if l != "" && slices.Equal(cmds, menus[MenuKey{Scope: s}]) {
+				continue
+			}
+			menus[MenuKey{s, l}] = cmds
+		}
+	}
+	return menus, keys
+}
+
+func menuOf(c Command) *Menu {
+	if mc, ok := c.(MenuCommand); ok {
+		if m := mc.CommandMenu(); m != nil {
+			return m
+		}
+	}
+	return &Menu{}
+}
+
+func (m *Menu) scopes() []tg.CommandScope {
+	if len(m.Scopes) == 0 {
+		return []tg.CommandScope{tg.CommandScopeDefault()}
+	}
+	return m.Scopes
+}
+
+// MenuChange represents the change of the commands list.
+type MenuChange struct {
+	MenuKey
+
+	// Commands contains the new list. Nil means the list will be deleted.
+	Commands []tg.Command
+}
+
+func (c MenuChange) String() string {
+	if c.Commands == nil {
+		return "delete " + c.MenuKey.String()
+	}
+	names := make([]string, len(c.Commands))
+	for i := range c.Commands {
+		names[i] = "/" + c.Commands[i].Command
+	}
+	return "set " + c.MenuKey.String() + ": " + strings.Join(names, ", ")
+}
+
+// MenuPlan contains the changes needed to synchronize the menus.
+type MenuPlan []MenuChange
+
+// String returns the plan as a human-readable text, one change per line.
+func (p MenuPlan) String() string {
+	if len(p) == 0 {
+		return "no changes"
+	}
+	lines := make([]string, len(p))
+	for i := range p {
+		lines[i] = p[i].String()
+	}
+	return strings.Join(lines, "\n")
+}
+
+// Apply applies the changes.
+func (p MenuPlan) Apply(b *tgot.Bot) error {
+	for _, c := range p {
+		var err error
+		if c.Commands == nil {
+			err = b.DeleteCommands(c.Scope, c.Lang)
+		} else {
+			err = b.SetCommands(&tgot.CommandsData{
+				Commands: c.Commands,
+				Scope:    c.Scope,
+				Lang:     c.Lang,
+			})
+		}
+		if err != nil {
+			return fmt.Errorf("%s: %w", c.MenuKey, err)
+		}
+	}
+	return nil
+}
+
+// PlanMenus compares the menus with the current bot's commands and returns
+// the changes needed to synchronize them.
+// The omitted localized lists (see Menus) of the declared languages are
+// deleted in each declared scope. The check contains the additional scopes
+// and languages that should be deleted if they are not declared (e.g. the
+// scopes and languages removed from the code).
+func (list List) PlanMenus(b *tgot.Bot, check ...MenuKey) (MenuPlan, error) {
+	menus, keys := list.menus()
+	slices.SortFunc(keys, func(a, b MenuKey) int {
+		return strings.Compare(a.String(), b.String())
+	})
+	for _, k := range check {
+		if !slices.Contains(keys, k) {
+			keys = append(keys, k)
+		}
+	}
+
+	var plan MenuPlan
+	for _, k := range keys {
+		current, err := b.GetCommands(k.Scope, k.Lang)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", k, err)
+		}
+		desired, ok := menus[k]
+		switch {
+		case !ok && len(current) > 0:
+			plan = append(plan, MenuChange{MenuKey: k})
+		case ok && !slices.Equal(current, desired):
+			if desired == nil {
+				desired = []tg.Command{}
+			}
+			plan = append(plan, MenuChange{MenuKey: k, Commands: desired})
+		}
+	}
+	return plan, nil
+}
+
+// SyncMenus synchronizes the bot's commands with the menus and returns the
+// applied changes. Use PlanMenus for a dry run.
+func (list List) SyncMenus(b *tgot.Bot, check ...MenuKey) (MenuPlan, error) {
+	plan, err := list.PlanMenus(b, check...)
+	if err != nil {
+		return nil, err
+	}
+	return plan, plan.Apply(b)
+}
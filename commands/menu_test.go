@@ -0,0 +1,50 @@
+package commands
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/karalef/tgot/api/tg"
+)
+
+func TestMenus(t *testing.T) {
+	admins := tg.CommandScopeAllChatAdmins()
+	list := List{
+		SimpleCommand{Command: "help", Desc: "help", Menu: &Menu{
+			Scopes:       []tg.CommandScope{tg.CommandScopeDefault(), admins},
+			Descriptions: map[string]string{"ru": "помощь"},
+		}},
+		SimpleCommand{Command: "ban", Desc: "ban", Menu: &Menu{Scopes: []tg.CommandScope{admins}}},
+		SimpleCommand{Command: "debug", Desc: "debug", Menu: &Menu{Hidden: true}},
+		SimpleCommand{Command: "start", Desc: "start"},
+	}
+	def := tg.CommandScopeDefault()
+	expected := map[MenuKey][]tg.Command{
+		{def, ""}:      {{Command: "help", Description: "help"}, {Command: "start", Description: "start"}},
+		{def, "ru"}:    {{Command: "help", Description: "помощь"}, {Command: "start", Description: "start"}},
+		{admins, ""}:   {{Command: "help", Description: "help"}, {Command: "ban", Description: "ban"}},
+		{admins, "ru"}: {{Command: "help", Description: "помощь"}, {Command: "ban", Description: "ban"}},
+	}
+	menus := list.Menus()
+	if len(menus) != len(expected) {
+		t.Fatalf("expected %d menus, got %v", len(expected), menus)
+	}
+	for k, cmds := range expected {
+		if !slices.Equal(menus[k], cmds) {
+			t.Fatalf("%s: expected %v, got %v", k, cmds, menus[k])
+		}
+	}
+
+	// the localized list equal to the default one is omitted
+	list[0] = SimpleCommand{Command: "help", Desc: "help", Menu: &Menu{
+		Descriptions: map[string]string{"ru": "help"},
+	}}
+	menus, keys := list.menus()
+	if _, ok := menus[MenuKey{def, "ru"}]; ok {
+		t.Fatal("unexpected localized menu")
+	}
+	// but it is checked to delete the stale one
+	if !slices.Contains(keys, MenuKey{def, "ru"}) {
+		t.Fatalf("omitted localized menu is not checked: %v", keys)
+	}
+}
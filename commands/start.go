@@ -0,0 +1,129 @@
+package commands
+
+import (
+	"github.com/karalef/tgot"
+	"github.com/karalef/tgot/api/deeplink"
+	"github.com/karalef/tgot/api/tg"
+)
+
+var _ Command = StartRouter{}
+
+// Start contains the decoded /start payload.
+type Start struct {
+	// Payload is the raw start parameter.
+	Payload string
+
+	// Prefix selects the route.
+	Prefix string
+
+	// Args contains the decoded arguments.
+	Args []string
+
+	// Group is true if the bot is started in a group
+	// (e.g. via the 'startgroup' link).
+	Group bool
+
+	// Err is set if the payload cannot be decoded, the route rejects the
+	// arguments or the route returns an error.
+	Err error
+}
+
+// StartRoute handles the /start payload with the specific prefix.
+// Use StartFunc or StartHandler to create a route.
+type StartRoute interface {
+	handleStart(m *tgot.Message, msg *tg.Message, s *Start)
+}
+
+// StartFunc is the route that handles the raw start arguments.
+type StartFunc func(*tgot.Message, *tg.Message, Start) error
+
+func (f StartFunc) handleStart(m *tgot.Message, msg *tg.Message, s *Start) {
+	s.Err = f(m, msg, *s)
+}
+
+// StartHandler returns the route that parses the start arguments into T
+// (see ParseArgs) and calls f.
+func StartHandler[T any](f func(*tgot.Message, *tg.Message, Start, T) error) StartRoute {
+	return startHandler[T](f)
+}
+
+type startHandler[T any] func(*tgot.Message, *tg.Message, Start, T) error
+
+func (h startHandler[T]) handleStart(m *tgot.Message, msg *tg.Message, s *Start) {
+	var v T
+	if s.Err = ParseArgs(&v, s.Args); s.Err == nil {
+		s.Err = h(m, msg, *s, v)
+	}
+}
+
+// EncodeStart encodes the prefix and the arguments struct v (see FormatArgs)
+// into the start parameter. Use the deeplink package to build the link.
+func EncodeStart(prefix string, v any) (string, error) {
+	return deeplink.EncodeStart(prefix, FormatArgs(v)...)
+}
+
+// StartRouter is the /start command that dispatches the deep-link payloads
+// encoded by EncodeStart (or deeplink.EncodeStart) by prefix.
+type StartRouter struct {
+	// Routes contains the routes by prefix.
+	Routes map[string]StartRoute
+
+	// Func is called if there is no payload, the payload is invalid, there
+	// is no route for the prefix or the route fails.
+	Func func(*tgot.Message, *tg.Message, Start) error
+
+	Desc     string
+	FullDesc string
+
+	// Guard restricts who can run the command.
+	Guard *Guard
+
+	// Menu declares the scopes and localized descriptions of the command
+	// in the bot's menu (see List.SyncMenus).
+	Menu *Menu
+
+	// Cooldown limits how often the command can be run.
+	Cooldown *Cooldown
+}
+
+// Name returns command name.
+func (StartRouter) Name() string { return "start" }
+
+// Description returns command description.
+func (r StartRouter) Description() string { return r.Desc }
+
+// CommandGuard returns the command guard.
+func (r StartRouter) CommandGuard() *Guard { return r.Guard }
+
+// CommandMenu returns the command menu.
+func (r StartRouter) CommandMenu() *Menu { return r.Menu }
+
+// CommandCooldown returns the command cooldown.
+func (r StartRouter) CommandCooldown() *Cooldown { return r.Cooldown }
+
+// Is returns true if this command matches the given string.
+func (StartRouter) Is(cmd string) bool { return cmd == "start" }
+
+// Help generates help message.
+func (r StartRouter) Help() tgot.Text {
+	return help("start", r.Desc, r.FullDesc, []Arg{{Name: "payload"}})
+}
+
+// Run decodes the payload and runs the route.
+func (r StartRouter) Run(m *tgot.Message, msg *tg.Message, args []string) {
+	s := Start{Group: msg.Chat.Type != tg.ChatPrivate}
+	if len(args) > 0 {
+		s.Payload = args[0]
+		s.Prefix, s.Args, s.Err = deeplink.DecodeStart(s.Payload)
+	}
+	if s.Err == nil && s.Prefix != "" {
+		if route, ok := r.Routes[s.Prefix]; ok {
+			if route.handleStart(m, msg, &s); s.Err == nil {
+				return
+			}
+		}
+	}
+	if r.Func != nil {
+		r.Func(m, msg, s)
+	}
+}
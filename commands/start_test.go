@@ -0,0 +1,46 @@
+package commands
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/karalef/tgot"
+	"github.com/karalef/tgot/api/deeplink"
+	"github.com/karalef/tgot/api/tg"
+)
+
+func TestStartRouter(t *testing.T) {
+	errRoute := errors.New("route failed")
+	var got Start
+	r := StartRouter{
+		Routes: map[string]StartRoute{
+			"ok": StartFunc(func(_ *tgot.Message, _ *tg.Message, s Start) error {
+				got = s
+				return nil
+			}),
+			"fail": StartFunc(func(*tgot.Message, *tg.Message, Start) error { return errRoute }),
+		},
+		Func: func(_ *tgot.Message, _ *tg.Message, s Start) error {
+			got = s
+			return nil
+		},
+	}
+	msg := &tg.Message{Chat: &tg.Chat{ID: 1, Type: tg.ChatPrivate}}
+	encode := func(prefix string, args ...string) string {
+		p, err := deeplink.EncodeStart(prefix, args...)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return p
+	}
+
+	r.Run(nil, msg, []string{encode("ok", "a")})
+	if got.Prefix != "ok" || len(got.Args) != 1 || got.Args[0] != "a" || got.Err != nil {
+		t.Fatalf("unexpected start %+v", got)
+	}
+
+	r.Run(nil, msg, []string{encode("fail")})
+	if got.Prefix != "fail" || got.Err != errRoute {
+		t.Fatalf("route error is not passed to Func: %+v", got)
+	}
+}
@@ -0,0 +1,106 @@
+package commands
+
+import (
+	"errors"
+
+	"github.com/karalef/tgot"
+	"github.com/karalef/tgot/api/cmd"
+	"github.com/karalef/tgot/api/tg"
+)
+
+var _ Command = TypedCommand[struct{}]{}
+
+// TypedCommand represents command with declarative typed arguments.
+// The arguments are declared by the struct T (see ParseArgs).
+// If the arguments are invalid, the command replies with the localized usage
+// error and Func is not called. The error returned by Func is replied in the
+// same way, so Func can return a UsageError for the semantically invalid
+// arguments.
+type TypedCommand[T any] struct {
+	Command string
+	Aliases []string
+	Func    func(*tgot.Message, *tg.Message, T) error
+
+	Desc     string
+	FullDesc string
+
+	// Locale contains the localized usage errors by the user's language code.
+	Locale Localization
+
+	// Guard restricts who can run the command.
+	Guard *Guard
+
+	// Menu declares the scopes and localized descriptions of the command
+	// in the bot's menu (see List.SyncMenus).
+	Menu *Menu
+
+	// Cooldown limits how often the command can be run.
+	Cooldown *Cooldown
+}
+
+// Name returns command name.
+func (c TypedCommand[T]) Name() string { return c.Command }
+
+// Description returns command description.
+func (c TypedCommand[T]) Description() string { return c.Desc }
+
+// CommandGuard returns the command guard.
+func (c TypedCommand[T]) CommandGuard() *Guard { return c.Guard }
+
+// CommandMenu returns the command menu.
+func (c TypedCommand[T]) CommandMenu() *Menu { return c.Menu }
+
+// CommandCooldown returns the command cooldown.
+func (c TypedCommand[T]) CommandCooldown() *Cooldown { return c.Cooldown }
+
+// Validate checks the declaration of the arguments (see CheckArgs).
+func (c TypedCommand[T]) Validate() error { return CheckArgs(*new(T)) }
+
+// Args returns the declared arguments.
+func (c TypedCommand[T]) Args() []Arg { return ArgsOf(*new(T)) }
+
+// Run parses the arguments and runs command.
+func (c TypedCommand[T]) Run(m *tgot.Message, msg *tg.Message, args []string) {
+	c.runTokens(m, msg, c.Command, plainTokens(args))
+}
+
+func (c TypedCommand[T]) runTokens(m *tgot.Message, msg *tg.Message, _ string, tokens []cmd.Token) {
+	var v T
+	err := ParseTokens(&v, tokens)
+	if err == nil && c.Func != nil {
+		err = c.Func(m, msg, v)
+	}
+	if err != nil {
+		c.replyError(m, msg, err)
+	}
+}
+
+// replyError replies with the localized usage if err is a UsageError and
+// with the error text otherwise.
+func (c TypedCommand[T]) replyError(m *tgot.Message, msg *tg.Message, err error) {
+	var uerr *UsageError
+	if !errors.As(err, &uerr) {
+		m.ReplyText(err.Error())
+		return
+	}
+	var lang string
+	if msg.From != nil {
+		lang = msg.From.LanguageCode
+	}
+	m.Reply(usageError(c.Locale.For(lang).Format(uerr), c.Command, c.Args()))
+}
+
+func usageError(text, name string, args []Arg) tgot.Text {
+	return tgot.NewTextBuilder().
+		Plain(text+"\n\n").
+		Pre(usage(name, args), "").
+		Text()
+}
+
+// Is returns true if this command matches the given string.
+func (c TypedCommand[T]) Is(cmd string) bool { return is(c.Command, c.Aliases, cmd) }
+
+// Help generates help message.
+func (c TypedCommand[T]) Help() tgot.Text {
+	return help(c.Command, c.Desc, c.FullDesc, c.Args())
+}
@@ -0,0 +1,231 @@
+package tgot
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"math/rand/v2"
+	"net/http"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+
+	"github.com/karalef/tgot/api"
+	"github.com/karalef/tgot/api/tg"
+)
+
+// DefaultLinkTTL is the default time for which the file links are cached.
+// Telegram guarantees that the link is valid for at least 1 hour.
+const DefaultLinkTTL = 55 * time.Minute
+
+// DefaultDownloadRetries is the default number of the download resumptions.
+const DefaultDownloadRetries = 3
+
+// DefaultDownloadRetryDelay is the default delay before the first retry.
+// The delay is doubled on each retry up to maxDownloadRetryDelay.
+const DefaultDownloadRetryDelay = time.Second
+
+const maxDownloadRetryDelay = 30 * time.Second
+
+// ErrSizeMismatch is returned if the size of the downloaded file
+// differs from the tg.File.FileSize.
+var ErrSizeMismatch = errors.New("downloaded file size mismatch")
+
+// Downloader downloads files by their IDs.
+// It caches the file links, resumes the interrupted downloads and
+// writes the files atomically.
+//
+// The zero value is ready to use.
+type Downloader struct {
+	// LinkTTL is the time for which the file links are cached.
+	LinkTTL time.Duration // default: DefaultLinkTTL
+
+	// Retries is the number of attempts to resume the interrupted download.
+	Retries int // default: DefaultDownloadRetries
+
+	// RetryDelay is the base delay of the exponential backoff with jitter
+	// between the retries. The getFile rate limit errors are retried after
+	// the duration specified by Telegram instead. The HTTP errors of the file
+	// download (including 429) carry no such duration and use the backoff.
+	RetryDelay time.Duration // default: DefaultDownloadRetryDelay
+
+	// CacheDir is the directory where the downloaded files are stored by
+	// their unique IDs. If it is empty, the files are not cached.
+	// The cached file is used only if its size matches the known file size,
+	// so the files of unknown size are downloaded each time.
+	CacheDir string
+
+	mut   sync.Mutex
+	files map[string]cachedFile
+}
+
+type cachedFile struct {
+	file    *tg.File
+	expires time.Time
+}
+
+// File returns basic information about a file and prepares it for
+// downloading. The result is cached until the link expires.
+func (d *Downloader) File(ctx BaseContext, fileID string) (*tg.File, error) {
+	d.mut.Lock()
+	cached, ok := d.files[fileID]
+	d.mut.Unlock()
+	if ok && time.Now().Before(cached.expires) {
+		return cached.file, nil
+	}
+
+	f, err := method[*tg.File](ctx, "getFile", api.NewData().Set("file_id", fileID))
+	if err != nil {
+		return nil, err
+	}
+	ttl := d.LinkTTL
+	if ttl <= 0 {
+		ttl = DefaultLinkTTL
+	}
+
+	d.mut.Lock()
+	defer d.mut.Unlock()
+	now := time.Now()
+	if d.files == nil {
+		d.files = make(map[string]cachedFile)
+	}
+	for id, c := range d.files {
+		if !now.Before(c.expires) {
+			delete(d.files, id)
+		}
+	}
+	d.files[fileID] = cachedFile{file: f, expires: now.Add(ttl)}
+	return f, nil
+}
+
+func (d *Downloader) forget(fileID string) {
+	d.mut.Lock()
+	delete(d.files, fileID)
+	d.mut.Unlock()
+}
+
+// Download downloads the file to the path.
+// The file is written to the temporary file in the same directory and
+// renamed when it is complete, so the path never contains a partial file.
+func (d *Downloader) Download(ctx BaseContext, fileID, path string) error {
+	f, err := d.File(ctx, fileID)
+	if err != nil {
+		return err
+	}
+	if d.CacheDir == "" || f.UniqueID == "" {
+		return d.fetch(ctx, fileID, f, path)
+	}
+
+	cached := filepath.Join(d.CacheDir, f.UniqueID)
+	if fi, err := os.Stat(cached); err != nil || f.FileSize <= 0 || fi.Size() != f.FileSize {
+		if err = os.MkdirAll(d.CacheDir, 0o755); err != nil {
+			return err
+		}
+		if err = d.fetch(ctx, fileID, f, cached); err != nil {
+			return err
+		}
+	}
+	return writeAtomic(path, func(w io.Writer) error {
+		src, err := os.Open(cached)
+		if err != nil {
+			return err
+		}
+		defer src.Close()
+		_, err = io.Copy(w, src)
+		return err
+	})
+}
+
+// fetch downloads the file resuming it on failure and verifies its size.
+func (d *Downloader) fetch(ctx BaseContext, fileID string, f *tg.File, path string) error {
+	retries := d.Retries
+	if retries <= 0 {
+		retries = DefaultDownloadRetries
+	}
+	a := ctx.Bot().API()
+	return writeAtomic(path, func(w io.Writer) error {
+		var n int64
+		refreshed := false
+		for attempt := 0; ; attempt++ {
+			body, err := a.DownloadFileFrom(ctx, f.FilePath, n)
+			if err == nil {
+				var written int64
+				written, err = io.Copy(w, body)
+				body.Close()
+				n += written
+				if err == nil {
+					break
+				}
+			}
+			if ctx.Err() != nil {
+				return ctx.Err()
+			}
+			// the link has expired
+			var httpErr *api.HTTPError
+			if !refreshed && errors.As(err, &httpErr) && httpErr.Status == http.StatusNotFound {
+				d.forget(fileID)
+				var nf *tg.File
+				if nf, err = d.File(ctx, fileID); err == nil {
+					f, refreshed = nf, true
+					continue
+				}
+			}
+			if attempt >= retries {
+				return err
+			}
+			if err = sleep(ctx, d.retryDelay(attempt, err)); err != nil {
+				return err
+			}
+		}
+		if f.FileSize > 0 && n != f.FileSize {
+			return fmt.Errorf("%w: got %d bytes, expected %d", ErrSizeMismatch, n, f.FileSize)
+		}
+		return nil
+	})
+}
+
+// retryDelay returns the delay before the retry after the failed attempt.
+func (d *Downloader) retryDelay(attempt int, err error) time.Duration {
+	var apiErr *api.Error
+	if errors.As(err, &apiErr) && apiErr.Err.Parameters != nil && apiErr.Err.Parameters.RetryAfter != nil {
+		return apiErr.Err.Parameters.RetryAfter.Duration()
+	}
+	base := d.RetryDelay
+	if base <= 0 {
+		base = DefaultDownloadRetryDelay
+	}
+	delay := min(base<<min(attempt, 16), max(base, maxDownloadRetryDelay))
+	return delay/2 + rand.N(delay/2+1)
+}
+
+// sleep waits for the duration or until the ctx is done.
+func sleep(ctx BaseContext, d time.Duration) error {
+	t := time.NewTimer(d)
+	defer t.Stop()
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-t.C:
+		return nil
+	}
+}
+
+// writeAtomic writes the file using the temporary file in the same directory.
+func writeAtomic(path string, write func(io.Writer) error) error {
+	tmp, err := os.CreateTemp(filepath.Dir(path), "."+filepath.Base(path)+".*.part")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tmp.Name())
+	if err = write(tmp); err == nil {
+		err = tmp.Sync()
+	}
+	if cerr := tmp.Close(); err == nil {
+		err = cerr
+	}
+	if err != nil {
+		return err
+	}
+	return os.Rename(tmp.Name(), path)
+}
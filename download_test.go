@@ -0,0 +1,153 @@
+package tgot
+
+import (
+	"bytes"
+	stdcontext "context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/karalef/tgot/api"
+	"github.com/karalef/tgot/api/tg"
+)
+
+type fileServer struct {
+	content  []byte
+	size     int64
+	getFile  atomic.Int32
+	download atomic.Int32
+}
+
+func (s *fileServer) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	switch {
+	case strings.HasSuffix(r.URL.Path, "/getMe"):
+		w.Write([]byte(`{"ok":true,"result":{"id":1,"is_bot":true,"first_name":"bot"}}`))
+	case strings.HasSuffix(r.URL.Path, "/getFile"):
+		s.getFile.Add(1)
+		w.Write([]byte(`{"ok":true,"result":{"file_id":"id","file_unique_id":"unique",` +
+			`"file_size":` + strconv.FormatInt(s.size, 10) + `,"file_path":"docs/file"}}`))
+	case strings.HasSuffix(r.URL.Path, "/docs/file"):
+		// the first download is interrupted in the middle
+		if s.download.Add(1) == 1 {
+			w.Header().Set("Content-Length", strconv.Itoa(len(s.content)))
+			w.Write(s.content[:len(s.content)/2])
+			return
+		}
+		http.ServeContent(w, r, "file", time.Time{}, bytes.NewReader(s.content))
+	default:
+		http.NotFound(w, r)
+	}
+}
+
+func testBot(t *testing.T, h http.Handler) *Bot {
+	srv := httptest.NewServer(h)
+	t.Cleanup(srv.Close)
+	a, err := api.New("token", api.Config{
+		APIURL:  srv.URL + "/bot",
+		FileURL: srv.URL + "/file/bot",
+		Client:  api.NewHTTP(srv.Client()),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	b, err := New(a, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return b
+}
+
+func TestDownloader(t *testing.T) {
+	content := bytes.Repeat([]byte("0123456789"), 10000)
+	srv := &fileServer{content: content, size: int64(len(content))}
+	ctx := testBot(t, srv).NewContext(stdcontext.Background(), "test")
+
+	dir := t.TempDir()
+	d := &Downloader{CacheDir: filepath.Join(dir, "cache"), RetryDelay: time.Millisecond}
+	for _, name := range []string{"first", "second"} {
+		path := filepath.Join(dir, name)
+		if err := d.Download(ctx, "id", path); err != nil {
+			t.Fatal(err)
+		}
+		got, err := os.ReadFile(path)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !bytes.Equal(got, content) {
+			t.Fatalf("%s: content mismatch", name)
+		}
+	}
+	if n := srv.getFile.Load(); n != 1 {
+		t.Errorf("expected 1 getFile call, got %d", n)
+	}
+	// interrupted and resumed, the second one is cached
+	if n := srv.download.Load(); n != 2 {
+		t.Errorf("expected 2 downloads, got %d", n)
+	}
+	entries, _ := os.ReadDir(dir)
+	if len(entries) != 3 {
+		t.Errorf("unexpected files left in the directory: %v", entries)
+	}
+}
+
+func TestDownloaderSizeMismatch(t *testing.T) {
+	content := []byte("content")
+	srv := &fileServer{content: content, size: int64(len(content)) + 1}
+	srv.download.Store(1)
+	ctx := testBot(t, srv).NewContext(stdcontext.Background(), "test")
+
+	path := filepath.Join(t.TempDir(), "file")
+	err := new(Downloader).Download(ctx, "id", path)
+	if !errors.Is(err, ErrSizeMismatch) {
+		t.Fatalf("expected ErrSizeMismatch, got %v", err)
+	}
+	if _, err = os.Stat(path); !os.IsNotExist(err) {
+		t.Fatalf("the partial file is written: %v", err)
+	}
+}
+
+func TestDownloaderUnknownSize(t *testing.T) {
+	srv := &fileServer{content: []byte("content")}
+	srv.download.Store(1)
+	ctx := testBot(t, srv).NewContext(stdcontext.Background(), "test")
+
+	dir := t.TempDir()
+	d := &Downloader{CacheDir: filepath.Join(dir, "cache")}
+	for range 2 {
+		if err := d.Download(ctx, "id", filepath.Join(dir, "file")); err != nil {
+			t.Fatal(err)
+		}
+	}
+	// the cached file can not be verified without the size
+	if n := srv.download.Load(); n != 3 {
+		t.Errorf("expected 2 downloads, got %d", n-1)
+	}
+}
+
+func TestDownloaderRetryDelay(t *testing.T) {
+	d := &Downloader{RetryDelay: time.Second}
+	for attempt, expected := range []time.Duration{time.Second, 2 * time.Second, 4 * time.Second} {
+		if delay := d.retryDelay(attempt, io.ErrUnexpectedEOF); delay < expected/2 || delay > expected {
+			t.Errorf("attempt %d: delay %s is out of [%s, %s]", attempt, delay, expected/2, expected)
+		}
+	}
+	if delay := d.retryDelay(100, io.ErrUnexpectedEOF); delay > maxDownloadRetryDelay {
+		t.Errorf("delay %s exceeds the maximum", delay)
+	}
+
+	retryAfter := tg.Duration(5)
+	rateLimit := &api.Error{}
+	rateLimit.Err = &tg.Error{Code: http.StatusTooManyRequests, Parameters: &tg.ResponseParameters{RetryAfter: &retryAfter}}
+	if delay := d.retryDelay(0, fmt.Errorf("getFile: %w", rateLimit)); delay != 5*time.Second {
+		t.Errorf("expected retry after 5s, got %s", delay)
+	}
+}
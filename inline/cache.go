@@ -0,0 +1,264 @@
+package inline
+
+import (
+	"sync"
+	"time"
+
+	"github.com/karalef/tgot"
+	"github.com/karalef/tgot/api/tg"
+)
+
+// DefaultCacheTTL is the default time the answers are cached.
+const DefaultCacheTTL = time.Minute
+
+// Handler is the inline query handler.
+type Handler = func(tgot.Query[tgot.InlineAnswer], *tg.InlineQuery)
+
+// Cache caches the inline query answers by the query text and offset.
+// The concurrent identical queries are coalesced so the handler is called
+// only once. The queries that are superseded by a newer query from the same
+// user are answered with an empty result.
+//
+// The zero value is ready to use.
+type Cache struct {
+	// TTL is the time the answers are cached.
+	// Default is DefaultCacheTTL.
+	TTL time.Duration
+
+	// Personal caches the answers for each user separately.
+	// If it is false, the personal answers are neither cached nor shared
+	// with the coalesced queries of other users.
+	Personal bool
+
+	// Delay is the time to wait before handling the query. The query is
+	// dropped if a newer query from the same user arrives in the meantime.
+	Delay time.Duration
+
+	// OnError is called when the answer sent by the cache fails. The errors
+	// of the answers of the wrapped handler are returned to it.
+	OnError func(qc tgot.Query[tgot.InlineAnswer], err error)
+
+	mut     sync.Mutex
+	entries map[cacheKey]*cacheEntry
+	latest  map[tg.ID]*userQuery
+	sweep   time.Time
+}
+
+type cacheKey struct {
+	query  string
+	offset string
+	user   tg.ID
+}
+
+type cacheEntry struct {
+	done    chan struct{}
+	ans     tgot.InlineAnswer
+	ok      bool
+	expires time.Time
+
+	// personal is true if the answer is personal and is not shared with the
+	// coalesced queries.
+	personal bool
+}
+
+// userQuery is the latest query of the user.
+type userQuery struct {
+	id      string
+	pending int
+}
+
+// Wrap wraps the handler with the cache. The handler must answer the query
+// synchronously; the answer is sent and cached for the identical queries.
+// The answer that fails is not cached.
+//
+// It can be used as tgot.Router.OnInlineQuery handler:
+//
+//	router.OnInlineQuery = cache.Wrap(paginator.Handle)
+func (c *Cache) Wrap(h Handler) Handler {
+	return func(qc tgot.Query[tgot.InlineAnswer], q *tg.InlineQuery) {
+		c.begin(q)
+		defer c.end(q)
+
+		if c.Delay > 0 {
+			time.Sleep(c.Delay)
+			if c.stale(q) {
+				c.answer(qc, emptyAnswer())
+				return
+			}
+		}
+
+		e, leader := c.entry(q)
+		if !leader {
+			<-e.done
+		}
+		switch {
+		case leader:
+			c.run(e, h, qc, q)
+		case e.personal:
+			// the leader's answer is not shared
+			h(c.capture(qc, q, &captured{}), q)
+		case c.stale(q):
+			c.answer(qc, emptyAnswer())
+		case e.ok:
+			c.answer(qc, e.ans)
+		default:
+			// the leader's handler did not answer or the answer failed
+			c.answer(qc, emptyAnswer())
+		}
+	}
+}
+
+func (c *Cache) answer(qc tgot.Query[tgot.InlineAnswer], ans tgot.InlineAnswer) {
+	if err := qc.Answer(ans); err != nil && c.OnError != nil {
+		c.OnError(qc, err)
+	}
+}
+
+func emptyAnswer() tgot.InlineAnswer {
+	cacheTime := 0
+	return tgot.InlineAnswer{
+		Results:    []tg.InlineQueryResulter{},
+		CacheTime:  &cacheTime,
+		IsPersonal: true,
+	}
+}
+
+func (c *Cache) key(q *tg.InlineQuery) cacheKey {
+	k := cacheKey{query: q.Query, offset: q.Offset}
+	if c.Personal {
+		k.user = q.From.ID
+	}
+	return k
+}
+
+// entry returns the cache entry for the query. If there is no valid entry,
+// the new one is created and leader is true.
+func (c *Cache) entry(q *tg.InlineQuery) (e *cacheEntry, leader bool) {
+	now := time.Now()
+	key := c.key(q)
+
+	c.mut.Lock()
+	defer c.mut.Unlock()
+	if c.entries == nil {
+		c.entries = make(map[cacheKey]*cacheEntry)
+	}
+	if now.After(c.sweep) {
+		for k, e := range c.entries {
+			if e.expired(now) {
+				delete(c.entries, k)
+			}
+		}
+		c.sweep = now.Add(c.ttl())
+	}
+	if e, ok := c.entries[key]; ok && !e.expired(now) {
+		return e, false
+	}
+	e = &cacheEntry{done: make(chan struct{})}
+	c.entries[key] = e
+	return e, true
+}
+
+func (e *cacheEntry) expired(now time.Time) bool {
+	select {
+	case <-e.done:
+		return !e.ok || now.After(e.expires)
+	default:
+		return false
+	}
+}
+
+func (c *Cache) ttl() time.Duration {
+	if c.TTL > 0 {
+		return c.TTL
+	}
+	return DefaultCacheTTL
+}
+
+// run calls the handler and completes the entry.
+// The personal answer is not cached unless the cache is personal.
+func (c *Cache) run(e *cacheEntry, h Handler, qc tgot.Query[tgot.InlineAnswer], q *tg.InlineQuery) {
+	capt := &captured{}
+	defer func() {
+		e.personal = capt.ok && capt.ans.IsPersonal && !c.Personal
+		if e.ok = capt.ok && capt.err == nil && !e.personal; e.ok {
+			e.ans = capt.ans
+		}
+		e.expires = time.Now().Add(c.ttl())
+		if !e.ok {
+			c.mut.Lock()
+			if c.entries[c.key(q)] == e {
+				delete(c.entries, c.key(q))
+			}
+			c.mut.Unlock()
+		}
+		close(e.done)
+	}()
+	h(c.capture(qc, q, capt), q)
+}
+
+func (c *Cache) begin(q *tg.InlineQuery) {
+	c.mut.Lock()
+	defer c.mut.Unlock()
+	if c.latest == nil {
+		c.latest = make(map[tg.ID]*userQuery)
+	}
+	u, ok := c.latest[q.From.ID]
+	if !ok {
+		u = &userQuery{}
+		c.latest[q.From.ID] = u
+	}
+	u.id = q.ID
+	u.pending++
+}
+
+func (c *Cache) end(q *tg.InlineQuery) {
+	c.mut.Lock()
+	defer c.mut.Unlock()
+	if u := c.latest[q.From.ID]; u != nil {
+		if u.pending--; u.pending == 0 {
+			delete(c.latest, q.From.ID)
+		}
+	}
+}
+
+// stale reports whether the query is superseded.
+func (c *Cache) stale(q *tg.InlineQuery) bool {
+	c.mut.Lock()
+	defer c.mut.Unlock()
+	u := c.latest[q.From.ID]
+	return u != nil && u.id != q.ID
+}
+
+type captured struct {
+	ans tgot.InlineAnswer
+	ok  bool
+	err error
+}
+
+// capture records the first answer of the wrapped handler and sends it or
+// the empty answer if the query is superseded.
+type capture struct {
+	tgot.Query[tgot.InlineAnswer]
+	capt  *captured
+	stale func() bool
+}
+
+func (c *Cache) capture(qc tgot.Query[tgot.InlineAnswer], q *tg.InlineQuery, capt *captured) capture {
+	return capture{qc, capt, func() bool { return c.stale(q) }}
+}
+
+func (c capture) WithName(name string) tgot.Query[tgot.InlineAnswer] {
+	return capture{c.Query.WithName(name), c.capt, c.stale}
+}
+
+func (c capture) Answer(ans tgot.InlineAnswer) error {
+	if c.capt.ok {
+		return c.Query.Answer(ans)
+	}
+	c.capt.ans, c.capt.ok = ans, true
+	if c.stale() {
+		ans = emptyAnswer()
+	}
+	c.capt.err = c.Query.Answer(ans)
+	return c.capt.err
+}
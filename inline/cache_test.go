@@ -0,0 +1,227 @@
+package inline
+
+import (
+	"errors"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/karalef/tgot"
+	"github.com/karalef/tgot/api/tg"
+)
+
+type answers struct {
+	mut sync.Mutex
+	m   map[string]tgot.InlineAnswer
+}
+
+func (a *answers) get(id string) tgot.InlineAnswer {
+	a.mut.Lock()
+	defer a.mut.Unlock()
+	return a.m[id]
+}
+
+type fakeQuery struct {
+	tgot.Query[tgot.InlineAnswer]
+	answers *answers
+	id      string
+}
+
+func (f *fakeQuery) Answer(ans tgot.InlineAnswer) error {
+	f.answers.mut.Lock()
+	f.answers.m[f.id] = ans
+	f.answers.mut.Unlock()
+	return nil
+}
+
+func newAnswers() (*answers, func(id string) tgot.Query[tgot.InlineAnswer]) {
+	a := &answers{m: make(map[string]tgot.InlineAnswer)}
+	return a, func(id string) tgot.Query[tgot.InlineAnswer] {
+		return &fakeQuery{answers: a, id: id}
+	}
+}
+
+func query(id string, user tg.ID, text string) *tg.InlineQuery {
+	return &tg.InlineQuery{ID: id, From: tg.User{ID: user}, Query: text}
+}
+
+func results(text string) tgot.InlineAnswer {
+	return tgot.InlineAnswer{Results: []tg.InlineQueryResulter{
+		&tg.InlineQueryResult[tg.InlineQueryResultData]{ID: text},
+	}}
+}
+
+func TestCache(t *testing.T) {
+	var calls atomic.Int32
+	release := make(chan struct{})
+	h := func(qc tgot.Query[tgot.InlineAnswer], q *tg.InlineQuery) {
+		calls.Add(1)
+		<-release
+		qc.Answer(results(q.Query))
+	}
+	var c Cache
+	handle := c.Wrap(h)
+	ans, with := newAnswers()
+
+	// coalesced
+	var wg sync.WaitGroup
+	for i := range 3 {
+		wg.Add(1)
+		id := string(rune('a' + i))
+		go func() {
+			defer wg.Done()
+			handle(with(id), query(id, tg.ID(i+1), "x"))
+		}()
+	}
+	time.Sleep(20 * time.Millisecond)
+	close(release)
+	wg.Wait()
+	if n := calls.Load(); n != 1 {
+		t.Fatalf("expected 1 call, got %d", n)
+	}
+	for _, id := range []string{"a", "b", "c"} {
+		if len(ans.get(id).Results) != 1 {
+			t.Fatalf("query %s is not answered: %+v", id, ans.get(id))
+		}
+	}
+
+	// cached
+	handle(with("d"), query("d", 4, "x"))
+	if n := calls.Load(); n != 1 || len(ans.get("d").Results) != 1 {
+		t.Fatalf("expected cached answer, %d calls", n)
+	}
+}
+
+func TestCacheSuperseded(t *testing.T) {
+	started := make(chan struct{})
+	release := make(chan struct{})
+	h := func(qc tgot.Query[tgot.InlineAnswer], q *tg.InlineQuery) {
+		if q.Query == "a" {
+			close(started)
+			<-release
+		}
+		qc.Answer(results(q.Query))
+	}
+	var c Cache
+	handle := c.Wrap(h)
+	ans, with := newAnswers()
+
+	done := make(chan struct{})
+	go func() {
+		handle(with("1"), query("1", 1, "a"))
+		close(done)
+	}()
+	<-started
+	handle(with("2"), query("2", 1, "ab"))
+	close(release)
+	<-done
+
+	if a := ans.get("1"); a.Results == nil || len(a.Results) != 0 {
+		t.Fatalf("expected empty answer for stale query, got %+v", a)
+	}
+	if len(ans.get("2").Results) != 1 {
+		t.Fatalf("expected answer, got %+v", ans.get("2"))
+	}
+}
+
+func TestCacheDelay(t *testing.T) {
+	var calls atomic.Int32
+	h := func(qc tgot.Query[tgot.InlineAnswer], q *tg.InlineQuery) {
+		calls.Add(1)
+		qc.Answer(results(q.Query))
+	}
+	c := Cache{Delay: 30 * time.Millisecond}
+	handle := c.Wrap(h)
+	ans, with := newAnswers()
+
+	var wg sync.WaitGroup
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		handle(with("1"), query("1", 1, "a"))
+	}()
+	time.Sleep(5 * time.Millisecond)
+	handle(with("2"), query("2", 1, "ab"))
+	wg.Wait()
+
+	if n := calls.Load(); n != 1 {
+		t.Fatalf("expected 1 call, got %d", n)
+	}
+	if len(ans.get("1").Results) != 0 || len(ans.get("2").Results) != 1 {
+		t.Fatalf("unexpected answers %+v", ans.m)
+	}
+}
+
+func TestCachePersonal(t *testing.T) {
+	var calls atomic.Int32
+	release := make(chan struct{})
+	h := func(qc tgot.Query[tgot.InlineAnswer], q *tg.InlineQuery) {
+		calls.Add(1)
+		<-release
+		ans := results(q.ID)
+		ans.IsPersonal = true
+		qc.Answer(ans)
+	}
+	var c Cache
+	handle := c.Wrap(h)
+	ans, with := newAnswers()
+
+	var wg sync.WaitGroup
+	for i, id := range []string{"a", "b"} {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			handle(with(id), query(id, tg.ID(i+1), "x"))
+		}()
+	}
+	time.Sleep(20 * time.Millisecond)
+	close(release)
+	wg.Wait()
+
+	if n := calls.Load(); n != 2 {
+		t.Fatalf("expected 2 calls, got %d", n)
+	}
+	for _, id := range []string{"a", "b"} {
+		if a := ans.get(id); len(a.Results) != 1 || a.Results[0].(*tg.InlineQueryResult[tg.InlineQueryResultData]).ID != id {
+			t.Fatalf("query %s got another user's answer: %+v", id, a)
+		}
+	}
+
+	// not cached
+	handle(with("c"), query("c", 3, "x"))
+	if n := calls.Load(); n != 3 {
+		t.Fatalf("personal answer is cached, %d calls", n)
+	}
+}
+
+type failingQuery struct {
+	tgot.Query[tgot.InlineAnswer]
+	err error
+}
+
+func (f failingQuery) Answer(tgot.InlineAnswer) error { return f.err }
+
+func TestCacheAnswerError(t *testing.T) {
+	errAnswer := errors.New("answer failed")
+	var calls atomic.Int32
+	var got error
+	h := func(qc tgot.Query[tgot.InlineAnswer], q *tg.InlineQuery) {
+		calls.Add(1)
+		got = qc.Answer(results(q.Query))
+	}
+	var c Cache
+	handle := c.Wrap(h)
+
+	handle(failingQuery{err: errAnswer}, query("1", 1, "x"))
+	if got != errAnswer {
+		t.Fatalf("expected answer error, got %v", got)
+	}
+
+	// the failed answer is not cached
+	ans, with := newAnswers()
+	handle(with("2"), query("2", 2, "x"))
+	if n := calls.Load(); n != 2 || len(ans.get("2").Results) != 1 {
+		t.Fatalf("failed answer is cached, %d calls", n)
+	}
+}
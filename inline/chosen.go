@@ -0,0 +1,91 @@
+package inline
+
+import (
+	"sync"
+	"time"
+
+	"github.com/karalef/tgot"
+	"github.com/karalef/tgot/api/tg"
+)
+
+// DefaultChosenTTL is the default time the chosen results are kept.
+const DefaultChosenTTL = 10 * time.Minute
+
+// NewChosen creates a new chosen results store.
+// If the ttl is not positive, DefaultChosenTTL is used.
+func NewChosen[T any](ttl time.Duration, handler func(*tgot.Message, *tg.InlineChosen, T)) *Chosen[T] {
+	if ttl <= 0 {
+		ttl = DefaultChosenTTL
+	}
+	return &Chosen[T]{
+		ttl:     ttl,
+		handler: handler,
+		items:   make(map[string]chosenItem[T]),
+		now:     time.Now,
+	}
+}
+
+// Chosen correlates the chosen inline results with the items they were made
+// from. The items are stored by the result id when the query is answered and
+// passed to the handler when the result is chosen.
+//
+// The answers served from Cache or from the Telegram cache are shared between
+// the users and do not store the items again, so the result ids must identify
+// the items regardless of the user (see Paginator.ID) and the ttl should
+// exceed the cache time.
+//
+// Telegram sends the chosen results only if the inline feedback is enabled
+// for the bot with the /setinlinefeedback command in @BotFather. The message
+// can be edited via tgot.InlineMsgID only if the result has an inline
+// keyboard attached.
+type Chosen[T any] struct {
+	ttl     time.Duration
+	handler func(*tgot.Message, *tg.InlineChosen, T)
+	now     func() time.Time
+
+	mut   sync.Mutex
+	items map[string]chosenItem[T]
+	sweep time.Time
+}
+
+type chosenItem[T any] struct {
+	item    T
+	expires time.Time
+}
+
+// Put stores the item of the sent result.
+func (c *Chosen[T]) Put(resultID string, item T) {
+	now := c.now()
+	c.mut.Lock()
+	defer c.mut.Unlock()
+	if now.After(c.sweep) {
+		for k, v := range c.items {
+			if now.After(v.expires) {
+				delete(c.items, k)
+			}
+		}
+		c.sweep = now.Add(c.ttl)
+	}
+	c.items[resultID] = chosenItem[T]{item, now.Add(c.ttl)}
+}
+
+// Get returns the item of the chosen result.
+func (c *Chosen[T]) Get(resultID string) (item T, ok bool) {
+	c.mut.Lock()
+	defer c.mut.Unlock()
+	v, ok := c.items[resultID]
+	if !ok || c.now().After(v.expires) {
+		return item, false
+	}
+	return v.item, true
+}
+
+// Handle calls the handler with the item of the chosen result.
+// The results that are unknown or expired are ignored.
+//
+// It can be used as tgot.Router.OnInlineChosen handler.
+func (c *Chosen[T]) Handle(m *tgot.Message, r *tg.InlineChosen) {
+	if item, ok := c.Get(r.ResultID); ok && c.handler != nil {
+		c.handler(m, r, item)
+	}
+}
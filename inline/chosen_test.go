@@ -0,0 +1,47 @@
+package inline
+
+import (
+	"testing"
+	"time"
+
+	"github.com/karalef/tgot"
+	"github.com/karalef/tgot/api/tg"
+)
+
+func TestChosen(t *testing.T) {
+	now := time.Unix(0, 0)
+	var got []string
+	c := NewChosen(time.Minute, func(_ *tgot.Message, _ *tg.InlineChosen, item string) {
+		got = append(got, item)
+	})
+	c.now = func() time.Time { return now }
+
+	p := Paginator[string]{
+		Source: Filter([]string{"a", "b"}, nil),
+		Result: func(item string) tg.InlineQueryResultData {
+			return tg.InlineQueryResultArticle{Title: item}
+		},
+		ID:     func(item string) string { return "id-" + item },
+		Chosen: c,
+	}
+	if _, err := p.Build(&tg.InlineQuery{From: tg.User{ID: 1}}); err != nil {
+		t.Fatal(err)
+	}
+
+	c.Handle(nil, &tg.InlineChosen{ResultID: "id-b", From: tg.User{ID: 1}})
+	// the cached answer can be shared with another user
+	c.Handle(nil, &tg.InlineChosen{ResultID: "id-b", From: tg.User{ID: 2}})
+	c.Handle(nil, &tg.InlineChosen{ResultID: "id-c", From: tg.User{ID: 1}})
+	if len(got) != 2 || got[0] != "b" || got[1] != "b" {
+		t.Fatalf("unexpected items %v", got)
+	}
+
+	now = now.Add(2 * time.Minute)
+	if _, ok := c.Get("id-a"); ok {
+		t.Fatal("expired item is returned")
+	}
+	c.Put("id-c", "c")
+	if len(c.items) != 1 {
+		t.Fatalf("expired items are not swept: %d", len(c.items))
+	}
+}
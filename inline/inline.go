@@ -0,0 +1,208 @@
+// Package inline provides the helpers for answering inline queries.
+package inline
+
+import (
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/binary"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/karalef/tgot"
+	"github.com/karalef/tgot/api/tg"
+)
+
+// MaxResults is the maximum number of results in the answer.
+const MaxResults = 50
+
+// ErrOffset is returned when the query offset is not produced by Paginator.
+var ErrOffset = errors.New("inline: invalid offset")
+
+// Source provides the items for the inline query.
+type Source[T any] interface {
+	// Results returns at most limit items matching the query starting
+	// from offset.
+	Results(q *tg.InlineQuery, offset, limit int) ([]T, error)
+}
+
+// SourceFunc is a function that implements Source.
+type SourceFunc[T any] func(q *tg.InlineQuery, offset, limit int) ([]T, error)
+
+// Results implements Source.
+func (f SourceFunc[T]) Results(q *tg.InlineQuery, offset, limit int) ([]T, error) {
+	return f(q, offset, limit)
+}
+
+// Filter returns the source over the items matching the query text.
+// If match is nil, the items are matched by the case-insensitive substring
+// of their string representation.
+func Filter[T any](items []T, match func(item T, query string) bool) Source[T] {
+	if match == nil {
+		match = func(item T, query string) bool {
+			s := strings.ToLower(fmt.Sprint(item))
+			return strings.Contains(s, strings.ToLower(query))
+		}
+	}
+	return SourceFunc[T](func(q *tg.InlineQuery, offset, limit int) ([]T, error) {
+		var res []T
+		for _, item := range items {
+			if len(res) == limit {
+				break
+			}
+			if !match(item, q.Query) {
+				continue
+			}
+			if offset > 0 {
+				offset--
+				continue
+			}
+			res = append(res, item)
+		}
+		return res, nil
+	})
+}
+
+// EncodeOffset encodes the number of the skipped results to the opaque
+// query offset. The zero offset is encoded as empty string.
+func EncodeOffset(n int) string {
+	if n <= 0 {
+		return ""
+	}
+	return base64.RawURLEncoding.EncodeToString(binary.AppendUvarint(nil, uint64(n)))
+}
+
+// DecodeOffset decodes the query offset produced by EncodeOffset.
+func DecodeOffset(s string) (int, error) {
+	if s == "" {
+		return 0, nil
+	}
+	b, err := base64.RawURLEncoding.DecodeString(s)
+	if err != nil {
+		return 0, ErrOffset
+	}
+	n, l := binary.Uvarint(b)
+	if l != len(b) || n == 0 || n > uint64(^uint(0)>>1) {
+		return 0, ErrOffset
+	}
+	return int(n), nil
+}
+
+// ResultID returns the stable result id derived from the result content.
+func ResultID(r tg.InlineQueryResultData) string {
+	b, _ := json.Marshal(&tg.InlineQueryResult[tg.InlineQueryResultData]{Result: r})
+	sum := sha256.Sum256(b)
+	return hex.EncodeToString(sum[:16])
+}
+
+// Paginator answers the inline queries with the results from the source
+// page by page. The next page offset is encoded opaquely.
+//
+// It can be used as tgot.Router.OnInlineQuery handler:
+//
+//	router.OnInlineQuery = paginator.Handle
+type Paginator[T any] struct {
+	Source Source[T]
+
+	// Result makes the result for the item.
+	Result func(item T) tg.InlineQueryResultData
+
+	// ID returns the stable item id up to 64 bytes.
+	// If it is nil, ResultID is used.
+	ID func(item T) string
+
+	// PageSize is the number of results on a page.
+	// Default and maximum is MaxResults.
+	PageSize int
+
+	// CacheTime is the maximum amount of time in seconds that the result may
+	// be cached on the server. Telegram uses 300 seconds if it is nil.
+	CacheTime *int
+
+	// Personal means that the results must be cached only for the user
+	// that sent the query.
+	Personal bool
+
+	// Button returns the button to be shown above the results.
+	// It is optional.
+	Button func(q *tg.InlineQuery) *tg.InlineQueryResultsButton
+
+	// Chosen stores the items of the sent results to be passed to the chosen
+	// result handler. It is optional.
+	Chosen *Chosen[T]
+
+	// OnError is called by Handle when the answer fails.
+	OnError func(q tgot.Query[tgot.InlineAnswer], err error)
+}
+
+// Build makes the answer to the inline query.
+func (p *Paginator[T]) Build(q *tg.InlineQuery) (tgot.InlineAnswer, error) {
+	offset, err := DecodeOffset(q.Offset)
+	if err != nil {
+		return tgot.InlineAnswer{}, err
+	}
+	size := p.PageSize
+	if size <= 0 || size > MaxResults {
+		size = MaxResults
+	}
+
+	// one more item to know if there is the next page
+	items, err := p.Source.Results(q, offset, size+1)
+	if err != nil {
+		return tgot.InlineAnswer{}, err
+	}
+	ans := tgot.InlineAnswer{
+		CacheTime:  p.CacheTime,
+		IsPersonal: p.Personal,
+	}
+	if len(items) > size {
+		items = items[:size]
+		ans.NextOffset = EncodeOffset(offset + size)
+	}
+	if p.Button != nil {
+		ans.Button = p.Button(q)
+	}
+
+	ans.Results = make([]tg.InlineQueryResulter, 0, len(items))
+	seen := make(map[string]struct{}, len(items))
+	for _, item := range items {
+		r := p.Result(item)
+		var id string
+		if p.ID != nil {
+			id = p.ID(item)
+		} else {
+			id = ResultID(r)
+		}
+		// the ids must be unique in the answer
+		if _, ok := seen[id]; ok {
+			continue
+		}
+		seen[id] = struct{}{}
+		if p.Chosen != nil {
+			p.Chosen.Put(id, item)
+		}
+		ans.Results = append(ans.Results, &tg.InlineQueryResult[tg.InlineQueryResultData]{
+			ID:     id,
+			Result: r,
+		})
+	}
+	return ans, nil
+}
+
+// Answer answers the inline query.
+func (p *Paginator[T]) Answer(qc tgot.Query[tgot.InlineAnswer], q *tg.InlineQuery) error {
+	ans, err := p.Build(q)
+	if err != nil {
+		return err
+	}
+	return qc.Answer(ans)
+}
+
+// Handle answers the inline query and reports the error to OnError.
+func (p *Paginator[T]) Handle(qc tgot.Query[tgot.InlineAnswer], q *tg.InlineQuery) {
+	if err := p.Answer(qc, q); err != nil && p.OnError != nil {
+		p.OnError(qc, err)
+	}
+}
@@ -0,0 +1,78 @@
+package inline
+
+import (
+	"strconv"
+	"testing"
+
+	"github.com/karalef/tgot/api/tg"
+)
+
+func TestOffset(t *testing.T) {
+	for _, n := range []int{0, 1, 50, 127, 128, 1 << 20, 1<<62 + 3} {
+		s := EncodeOffset(n)
+		got, err := DecodeOffset(s)
+		if err != nil {
+			t.Fatalf("decode %d (%q): %v", n, s, err)
+		}
+		if got != n {
+			t.Fatalf("expected %d, got %d", n, got)
+		}
+	}
+	for _, s := range []string{"!", "AA", "gA", strconv.Itoa(42)} {
+		if _, err := DecodeOffset(s); err != ErrOffset {
+			t.Fatalf("expected error for %q, got %v", s, err)
+		}
+	}
+}
+
+func TestPaginator(t *testing.T) {
+	items := make([]int, 120)
+	for i := range items {
+		items[i] = i
+	}
+	p := Paginator[int]{
+		Source: Filter(items, nil),
+		Result: func(item int) tg.InlineQueryResultData {
+			return tg.InlineQueryResultArticle{Title: strconv.Itoa(item)}
+		},
+	}
+
+	q := &tg.InlineQuery{}
+	var ids []string
+	for page := 0; ; page++ {
+		ans, err := p.Build(q)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if n := len(ans.Results); n != min(MaxResults, len(items)-page*MaxResults) {
+			t.Fatalf("page %d: unexpected %d results", page, n)
+		}
+		for _, r := range ans.Results {
+			ids = append(ids, r.(*tg.InlineQueryResult[tg.InlineQueryResultData]).ID)
+		}
+		if ans.NextOffset == "" {
+			break
+		}
+		q.Offset = ans.NextOffset
+	}
+	if len(ids) != len(items) {
+		t.Fatalf("expected %d results, got %d", len(items), len(ids))
+	}
+
+	seen := make(map[string]bool)
+	for _, id := range ids {
+		if seen[id] {
+			t.Fatalf("duplicate id %s", id)
+		}
+		seen[id] = true
+	}
+	ans, _ := p.Build(&tg.InlineQuery{})
+	if id := ans.Results[1].(*tg.InlineQueryResult[tg.InlineQueryResultData]).ID; id != ids[1] {
+		t.Fatalf("unstable id %s, expected %s", id, ids[1])
+	}
+
+	ans, _ = p.Build(&tg.InlineQuery{Query: "11"})
+	if len(ans.Results) != 11 || ans.NextOffset != "" {
+		t.Fatalf("unexpected filtered answer %+v", ans)
+	}
+}
@@ -0,0 +1,96 @@
+package keyboard
+
+import "github.com/karalef/tgot/api/tg"
+
+// Callback returns the inline button that sends the callback query with the
+// data when pressed.
+func Callback(text, data string) tg.InlineKeyboardButton {
+	return tg.InlineKeyboardButton{Text: text, CallbackData: data}
+}
+
+// URL returns the inline button that opens the url.
+func URL(text, url string) tg.InlineKeyboardButton {
+	return tg.InlineKeyboardButton{Text: text, URL: url}
+}
+
+// WebApp returns the inline button that opens the Web App.
+func WebApp(text, url string) tg.InlineKeyboardButton {
+	return tg.InlineKeyboardButton{Text: text, WebApp: &tg.WebAppInfo{URL: url}}
+}
+
+// Login returns the inline button that authorizes the user.
+func Login(text string, login tg.LoginURL) tg.InlineKeyboardButton {
+	return tg.InlineKeyboardButton{Text: text, LoginURL: &login}
+}
+
+// SwitchInline returns the inline button that prompts the user to select one
+// of their chats and inserts the bot's username and the query.
+// The query can be empty.
+func SwitchInline(text, query string) tg.InlineKeyboardButton {
+	return tg.InlineKeyboardButton{Text: text, SwitchInline: query, SwitchInlineEmpty: query == ""}
+}
+
+// SwitchInlineCurrent returns the inline button that inserts the bot's
+// username and the query in the current chat. The query can be empty.
+func SwitchInlineCurrent(text, query string) tg.InlineKeyboardButton {
+	return tg.InlineKeyboardButton{Text: text, SwitchInlineCurrent: query, SwitchInlineCurrentEmpty: query == ""}
+}
+
+// SwitchInlineChosen returns the inline button that prompts the user to
+// select one of their chats of the specified type.
+func SwitchInlineChosen(text string, chosen tg.SwitchInlineQueryChosenChat) tg.InlineKeyboardButton {
+	return tg.InlineKeyboardButton{Text: text, SwitchInlineChosen: &chosen}
+}
+
+// CopyText returns the inline button that copies the text to the clipboard.
+func CopyText(text, copy string) tg.InlineKeyboardButton {
+	return tg.InlineKeyboardButton{Text: text, CopyText: &tg.CopyTextButton{Text: copy}}
+}
+
+// Pay returns the pay button. It must be the first button of the invoice
+// message keyboard.
+func Pay(text string) tg.InlineKeyboardButton {
+	return tg.InlineKeyboardButton{Text: text, Pay: true}
+}
+
+// Game returns the button that launches the game. It must be the first button
+// of the game message keyboard.
+func Game(text string) tg.InlineKeyboardButton {
+	return tg.InlineKeyboardButton{Text: text, CallbackGame: &tg.CallbackGame{}}
+}
+
+// Text returns the reply button that sends the text.
+func Text(text string) tg.KeyboardButton {
+	return tg.KeyboardButton{Text: text}
+}
+
+// RequestUsers returns the reply button that requests users.
+func RequestUsers(text string, req tg.KeyboardButtonRequestUsers) tg.KeyboardButton {
+	return tg.KeyboardButton{Text: text, RequestUsers: &req}
+}
+
+// RequestChat returns the reply button that requests a chat.
+func RequestChat(text string, req tg.KeyboardButtonRequestChat) tg.KeyboardButton {
+	return tg.KeyboardButton{Text: text, RequestChat: &req}
+}
+
+// RequestContact returns the reply button that sends the user's phone number.
+func RequestContact(text string) tg.KeyboardButton {
+	return tg.KeyboardButton{Text: text, RequestContact: true}
+}
+
+// RequestLocation returns the reply button that sends the user's location.
+func RequestLocation(text string) tg.KeyboardButton {
+	return tg.KeyboardButton{Text: text, RequestLocation: true}
+}
+
+// RequestPoll returns the reply button that asks the user to create a poll.
+// The empty type allows any poll.
+func RequestPoll(text string, typ tg.PollType) tg.KeyboardButton {
+	return tg.KeyboardButton{Text: text, RequestPoll: &tg.ButtonPollType{Type: typ}}
+}
+
+// ReplyWebApp returns the reply button that opens the Web App.
+func ReplyWebApp(text, url string) tg.KeyboardButton {
+	return tg.KeyboardButton{Text: text, WebApp: &tg.WebAppInfo{URL: url}}
+}
@@ -0,0 +1,280 @@
+// Package keyboard contains the inline and reply keyboard builders.
+package keyboard
+
+import (
+	"errors"
+	"fmt"
+	"unicode/utf8"
+
+	"github.com/karalef/tgot/api/tg"
+)
+
+// Telegram limits.
+const (
+	MaxCallbackData = 64
+	MaxButtons      = 100
+)
+
+// validation errors.
+var (
+	ErrEmptyText      = errors.New("button text is empty")
+	ErrCallbackData   = errors.New("callback data is longer than 64 bytes")
+	ErrTooManyButtons = errors.New("too many buttons")
+	ErrButtonAction   = errors.New("button must have exactly one action")
+)
+
+// Error is returned when the keyboard is invalid.
+type Error struct {
+	// Row and Col are the indexes of the invalid button.
+	// They are -1 if the error is related to the whole keyboard.
+	Row, Col int
+	Err      error
+}
+
+func (e *Error) Error() string {
+	if e.Row < 0 {
+		return "keyboard: " + e.Err.Error()
+	}
+	return fmt.Sprintf("keyboard: button [%d][%d]: %s", e.Row, e.Col, e.Err.Error())
+}
+
+func (e *Error) Unwrap() error { return e.Err }
+
+// layout arranges the buttons into rows.
+type layout[B any] struct {
+	rows     [][]B
+	perRow   int
+	width    int
+	textOf   func(B) string
+	newRow   bool
+	rowWidth int
+}
+
+// add appends the buttons to the current row wrapping them if needed.
+func (l *layout[B]) add(buttons ...B) {
+	for _, b := range buttons {
+		w := utf8.RuneCountInString(l.textOf(b))
+		if len(l.rows) == 0 || l.newRow || l.full(w) {
+			l.rows = append(l.rows, nil)
+			l.newRow = false
+			l.rowWidth = 0
+		}
+		last := len(l.rows) - 1
+		l.rows[last] = append(l.rows[last], b)
+		l.rowWidth += w
+	}
+}
+
+func (l *layout[B]) full(w int) bool {
+	row := l.rows[len(l.rows)-1]
+	if len(row) == 0 {
+		return false
+	}
+	return (l.perRow > 0 && len(row) >= l.perRow) ||
+		(l.width > 0 && l.rowWidth+w > l.width)
+}
+
+// row starts a new row with the buttons.
+func (l *layout[B]) row(buttons ...B) {
+	l.newRow = true
+	l.add(buttons...)
+	l.newRow = true
+}
+
+func (l *layout[B]) column(buttons ...B) {
+	for _, b := range buttons {
+		l.row(b)
+	}
+}
+
+func (l *layout[B]) grid(cols int, buttons ...B) {
+	for len(buttons) > 0 {
+		n := min(cols, len(buttons))
+		l.row(buttons[:n]...)
+		buttons = buttons[n:]
+	}
+}
+
+func (l *layout[B]) validate(check func(B) error) error {
+	n := 0
+	for i, row := range l.rows {
+		for j, b := range row {
+			if err := check(b); err != nil {
+				return &Error{Row: i, Col: j, Err: err}
+			}
+		}
+		n += len(row)
+	}
+	if n > MaxButtons {
+		return &Error{Row: -1, Col: -1, Err: ErrTooManyButtons}
+	}
+	return nil
+}
+
+// NewInline creates a new inline keyboard builder.
+func NewInline() *Inline {
+	return &Inline{l: layout[tg.InlineKeyboardButton]{
+		textOf: func(b tg.InlineKeyboardButton) string { return b.Text },
+	}}
+}
+
+// Inline builds the inline keyboard.
+//
+// The buttons added with Add are placed in the current row which is wrapped
+// if it contains MaxPerRow buttons or its text becomes longer than MaxWidth.
+// Row, Column and Grid always start a new row.
+type Inline struct {
+	l layout[tg.InlineKeyboardButton]
+}
+
+// MaxPerRow sets the maximum number of buttons in the row for Add.
+func (k *Inline) MaxPerRow(n int) *Inline { k.l.perRow = n; return k }
+
+// MaxWidth sets the maximum total text length (in characters) of the row
+// for Add. The row with the single button can be longer.
+func (k *Inline) MaxWidth(n int) *Inline { k.l.width = n; return k }
+
+// Add appends the buttons to the current row.
+func (k *Inline) Add(buttons ...tg.InlineKeyboardButton) *Inline { k.l.add(buttons...); return k }
+
+// Row adds the row of buttons.
+func (k *Inline) Row(buttons ...tg.InlineKeyboardButton) *Inline { k.l.row(buttons...); return k }
+
+// Column adds each button as a separate row.
+func (k *Inline) Column(buttons ...tg.InlineKeyboardButton) *Inline {
+	k.l.column(buttons...)
+	return k
+}
+
+// Grid adds the buttons in rows of cols buttons.
+func (k *Inline) Grid(cols int, buttons ...tg.InlineKeyboardButton) *Inline {
+	k.l.grid(cols, buttons...)
+	return k
+}
+
+// Build validates the keyboard and returns the markup.
+func (k *Inline) Build() (*tg.InlineKeyboardMarkup, error) {
+	if err := k.l.validate(checkInline); err != nil {
+		return nil, err
+	}
+	rows := k.l.rows
+	if rows == nil {
+		rows = [][]tg.InlineKeyboardButton{}
+	}
+	return &tg.InlineKeyboardMarkup{Keyboard: rows}, nil
+}
+
+// MustBuild is like Build but panics if the keyboard is invalid.
+func (k *Inline) MustBuild() *tg.InlineKeyboardMarkup {
+	m, err := k.Build()
+	if err != nil {
+		panic(err)
+	}
+	return m
+}
+
+func checkInline(b tg.InlineKeyboardButton) error {
+	if b.Text == "" {
+		return ErrEmptyText
+	}
+	actions := 0
+	for _, set := range []bool{
+		b.URL != "",
+		b.CallbackData != "",
+		b.WebApp != nil,
+		b.LoginURL != nil,
+		b.SwitchInline != "" || b.SwitchInlineEmpty,
+		b.SwitchInlineCurrent != "" || b.SwitchInlineCurrentEmpty,
+		b.SwitchInlineChosen != nil,
+		b.CopyText != nil,
+		b.CallbackGame != nil,
+		b.Pay,
+	} {
+		if set {
+			actions++
+		}
+	}
+	if actions != 1 {
+		return ErrButtonAction
+	}
+	if len(b.CallbackData) > MaxCallbackData {
+		return ErrCallbackData
+	}
+	return nil
+}
+
+// NewReply creates a new reply keyboard builder.
+func NewReply() *Reply {
+	return &Reply{l: layout[tg.KeyboardButton]{
+		textOf: func(b tg.KeyboardButton) string { return b.Text },
+	}}
+}
+
+// Reply builds the reply keyboard.
+// The layout methods work the same as in Inline.
+type Reply struct {
+	l layout[tg.KeyboardButton]
+	m tg.ReplyKeyboardMarkup
+}
+
+// MaxPerRow sets the maximum number of buttons in the row for Add.
+func (k *Reply) MaxPerRow(n int) *Reply { k.l.perRow = n; return k }
+
+// MaxWidth sets the maximum total text length (in characters) of the row
+// for Add. The row with the single button can be longer.
+func (k *Reply) MaxWidth(n int) *Reply { k.l.width = n; return k }
+
+// Add appends the buttons to the current row.
+func (k *Reply) Add(buttons ...tg.KeyboardButton) *Reply { k.l.add(buttons...); return k }
+
+// Row adds the row of buttons.
+func (k *Reply) Row(buttons ...tg.KeyboardButton) *Reply { k.l.row(buttons...); return k }
+
+// Column adds each button as a separate row.
+func (k *Reply) Column(buttons ...tg.KeyboardButton) *Reply { k.l.column(buttons...); return k }
+
+// Grid adds the buttons in rows of cols buttons.
+func (k *Reply) Grid(cols int, buttons ...tg.KeyboardButton) *Reply {
+	k.l.grid(cols, buttons...)
+	return k
+}
+
+// Resize requests clients to resize the keyboard vertically.
+func (k *Reply) Resize() *Reply { k.m.Resize = true; return k }
+
+// OneTime requests clients to hide the keyboard after use.
+func (k *Reply) OneTime() *Reply { k.m.OneTime = true; return k }
+
+// Persistent requests clients to always show the keyboard.
+func (k *Reply) Persistent() *Reply { k.m.IsPersistent = true; return k }
+
+// Selective shows the keyboard to specific users only.
+func (k *Reply) Selective() *Reply { k.m.Selective = true; return k }
+
+// Placeholder sets the input field placeholder.
+func (k *Reply) Placeholder(p string) *Reply { k.m.Placeholder = p; return k }
+
+// Build validates the keyboard and returns the markup.
+func (k *Reply) Build() (*tg.ReplyKeyboardMarkup, error) {
+	err := k.l.validate(func(b tg.KeyboardButton) error {
+		if b.Text == "" {
+			return ErrEmptyText
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	m := k.m
+	m.Keyboard = k.l.rows
+	return &m, nil
+}
+
+// MustBuild is like Build but panics if the keyboard is invalid.
+func (k *Reply) MustBuild() *tg.ReplyKeyboardMarkup {
+	m, err := k.Build()
+	if err != nil {
+		panic(err)
+	}
+	return m
+}
@@ -0,0 +1,83 @@
+package keyboard
+
+import (
+	"encoding/json"
+	"errors"
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/karalef/tgot/api/tg"
+)
+
+func TestInlineLayout(t *testing.T) {
+	m := NewInline().MaxPerRow(2).
+		Add(Callback("1", "1"), Callback("2", "2"), Callback("3", "3")).
+		Row(URL("site", "https://example.com")).
+		Add(Callback("4", "4")).
+		MustBuild()
+
+	expected := [][]string{{"1", "2"}, {"3"}, {"site"}, {"4"}}
+	if got := texts(m.Keyboard); !equal(got, expected) {
+		t.Fatalf("expected %v, got %v", expected, got)
+	}
+
+	m = NewInline().MaxWidth(10).
+		Add(Callback("first", "1"), Callback("second", "2"), Callback("3", "3")).
+		MustBuild()
+	expected = [][]string{{"first"}, {"second", "3"}}
+	if got := texts(m.Keyboard); !equal(got, expected) {
+		t.Fatalf("expected %v, got %v", expected, got)
+	}
+}
+
+func TestInlineValidate(t *testing.T) {
+	_, err := NewInline().Row(Callback("ok", "1"), Callback("long", strings.Repeat("a", 65))).Build()
+	var kerr *Error
+	if !errors.As(err, &kerr) || kerr.Row != 0 || kerr.Col != 1 || !errors.Is(err, ErrCallbackData) {
+		t.Fatalf("expected callback data error, got %v", err)
+	}
+
+	k := NewInline()
+	for range MaxButtons + 1 {
+		k.Add(Callback("b", "b"))
+	}
+	if _, err = k.Build(); !errors.Is(err, ErrTooManyButtons) {
+		t.Fatalf("expected too many buttons error, got %v", err)
+	}
+
+	if _, err = NewInline().Add(tg.InlineKeyboardButton{Text: "none"}).Build(); !errors.Is(err, ErrButtonAction) {
+		t.Fatalf("expected button action error, got %v", err)
+	}
+
+	// the empty query only inserts the bot's username
+	m, err := NewInline().Add(SwitchInline("share", "")).Build()
+	if err != nil {
+		t.Fatal(err)
+	}
+	b, _ := json.Marshal(m.Keyboard[0][0])
+	if !strings.Contains(string(b), `"switch_inline_query":""`) {
+		t.Fatalf("empty query is not sent: %s", b)
+	}
+	var decoded tg.InlineKeyboardButton
+	if err = json.Unmarshal(b, &decoded); err != nil || decoded != m.Keyboard[0][0] {
+		t.Fatalf("button is not decoded: %+v, %v", decoded, err)
+	}
+	if b, _ = json.Marshal(Callback("cb", "data")); strings.Contains(string(b), "switch_inline") {
+		t.Fatalf("unset query is sent: %s", b)
+	}
+}
+
+func texts(rows [][]tg.InlineKeyboardButton) [][]string {
+	res := make([][]string, len(rows))
+	for i := range rows {
+		for _, b := range rows[i] {
+			res[i] = append(res[i], b.Text)
+		}
+	}
+	return res
+}
+
+func equal(a, b [][]string) bool {
+	return slices.EqualFunc(a, b, slices.Equal)
+}
@@ -0,0 +1,330 @@
+package keyboard
+
+import (
+	"cmp"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/karalef/tgot"
+	"github.com/karalef/tgot/api/tg"
+	"github.com/karalef/tgot/router"
+)
+
+// default Menu parameters.
+const (
+	DefaultMenuTimeout = 30 * time.Minute
+	DefaultBackText    = "« Back"
+	DefaultYesText     = "Yes"
+	DefaultNoText      = "No"
+)
+
+// Screen is a menu screen.
+type Screen struct {
+	// Title is the screen text if Text is nil.
+	Title string
+
+	// Text renders the screen text.
+	Text func(*Session) tgot.EditText
+
+	// Items contains the static items.
+	Items []Item
+
+	// Dynamic renders the items that are appended to the static ones.
+	Dynamic func(*Session) []Item
+
+	// Columns is the number of item buttons in a row. Default is 1.
+	Columns int
+}
+
+func (sc *Screen) text(s *Session) tgot.EditText {
+	if sc.Text != nil {
+		return sc.Text(s)
+	}
+	return tgot.EditText{Text: sc.Title}
+}
+
+func (sc *Screen) items(s *Session) []Item {
+	if sc.Dynamic == nil {
+		return sc.Items
+	}
+	return append(sc.Items[:len(sc.Items):len(sc.Items)], sc.Dynamic(s)...)
+}
+
+// Item is a menu item.
+type Item struct {
+	text   func(*Session) string
+	button *tg.InlineKeyboardButton
+	press  func(*Session) error
+}
+
+// Open returns the item that opens the screen.
+func Open(text string, screen *Screen) Item {
+	return Item{
+		text:  func(*Session) string { return text },
+		press: func(s *Session) error { s.Open(screen); return nil },
+	}
+}
+
+// Action returns the item that calls f.
+// The current screen is rendered again after f returns.
+func Action(text string, f func(*Session) error) Item {
+	return Item{
+		text:  func(*Session) string { return text },
+		press: f,
+	}
+}
+
+// Toggle returns the item that switches the boolean value.
+func Toggle(text string, get func(*Session) bool, set func(*Session, bool) error) Item {
+	return Item{
+		text: func(s *Session) string {
+			if get(s) {
+				return "✅ " + text
+			}
+			return "⬜ " + text
+		},
+		press: func(s *Session) error { return set(s, !get(s)) },
+	}
+}
+
+// Confirm returns the item that asks the question and calls f if the user
+// confirms it.
+func Confirm(text, question string, f func(*Session) error) Item {
+	return Item{
+		text: func(*Session) string { return text },
+		press: func(s *Session) error {
+			s.Open(s.menu.confirm(question, f))
+			return nil
+		},
+	}
+}
+
+// Button returns the item with the custom button (e.g. URL).
+// The button must not be a callback button.
+func Button(b tg.InlineKeyboardButton) Item {
+	return Item{button: &b}
+}
+
+// Menu is an inline menu consisting of the screens.
+// It navigates by editing the same message and keeps the back stack per
+// message.
+type Menu struct {
+	Root      *Screen
+	Callbacks *router.Callbacks
+
+	// Timeout specifies how long the menu works after the last use.
+	// Default is DefaultMenuTimeout.
+	Timeout time.Duration
+
+	// BackText, YesText and NoText are the texts of the service buttons.
+	// Defaults are DefaultBackText, DefaultYesText and DefaultNoText.
+	BackText string
+	YesText  string
+	NoText   string
+}
+
+// Session is the state of the menu message.
+type Session struct {
+	// Values stores arbitrary values of the session.
+	Values map[string]any
+
+	// Msg is the context of the menu message.
+	Msg *tgot.Message
+
+	// Query is the current callback query.
+	// It is nil when the menu is sent.
+	Query *tg.CallbackQuery
+
+	menu   *Menu
+	mut    sync.Mutex
+	stack  []frame
+	seq    int
+	items  []Item
+	closed bool
+	answer tgot.CallbackAnswer
+}
+
+// frame is the opened screen. The id is unique within the session, so the
+// presses on the screens that are closed are detected.
+type frame struct {
+	screen *Screen
+	id     int
+}
+
+// Screen returns the current screen.
+func (s *Session) Screen() *Screen { return s.stack[len(s.stack)-1].screen }
+
+// Open opens the screen.
+func (s *Session) Open(screen *Screen) {
+	s.seq++
+	s.stack = append(s.stack, frame{screen, s.seq})
+}
+
+// Back returns to the previous screen.
+func (s *Session) Back() {
+	if len(s.stack) > 1 {
+		s.stack = s.stack[:len(s.stack)-1]
+	}
+}
+
+// Answer sets the callback query answer.
+func (s *Session) Answer(a tgot.CallbackAnswer) { s.answer = a }
+
+// Close stops handling the menu. The message is not changed.
+func (s *Session) Close() { s.closed = true }
+
+// Send sends the root screen to the chat and starts handling the menu.
+func (m *Menu) Send(c *tgot.Chat, opts ...tgot.SendOptions) (*tg.Message, error) {
+	s := m.newSession()
+	text, kb, err := s.render()
+	if err != nil {
+		return nil, err
+	}
+	msg, err := send(c, text, kb, opts...)
+	if err != nil {
+		return nil, err
+	}
+	s.Msg = tgot.WithMessage(c, tgot.ChatMsgID(msg))
+	m.Callbacks.Register(s.Msg.ID(), s)
+	return msg, nil
+}
+
+// Show shows the root screen by editing the message and starts handling the
+// menu. It can be used for inline messages.
+func (m *Menu) Show(msg *tgot.Message) error {
+	s := m.newSession()
+	s.Msg = msg
+	if err := s.show(); err != nil {
+		return err
+	}
+	m.Callbacks.Register(msg.ID(), s)
+	return nil
+}
+
+func (m *Menu) newSession() *Session {
+	return &Session{
+		Values: make(map[string]any),
+		menu:   m,
+		stack:  []frame{{screen: m.Root}},
+	}
+}
+
+func (m *Menu) confirm(question string, f func(*Session) error) *Screen {
+	return &Screen{
+		Title:   question,
+		Columns: 2,
+		Items: []Item{
+			Action(cmp.Or(m.YesText, DefaultYesText), func(s *Session) error {
+				s.Back()
+				return f(s)
+			}),
+			Action(cmp.Or(m.NoText, DefaultNoText), func(s *Session) error {
+				s.Back()
+				return nil
+			}),
+		},
+	}
+}
+
+// callback data prefixes.
+const (
+	menuItem = "mi:"
+	menuBack = "mb:"
+)
+
+var _ router.CallbackHandler = &Session{}
+
+// Name implements router.CallbackHandler.
+func (s *Session) Name() string { return "menu" }
+
+// Timeout implements router.CallbackHandler.
+func (s *Session) Timeout() time.Time {
+	if s.menu.Timeout > 0 {
+		return time.Now().Add(s.menu.Timeout)
+	}
+	return time.Now().Add(DefaultMenuTimeout)
+}
+
+// Cancel implements router.CallbackHandler.
+func (s *Session) Cancel(tgot.BaseContext, tgot.MessageID) {}
+
+// OnError implements router.CallbackHandler.
+func (s *Session) OnError(tgot.Query[tgot.CallbackAnswer], error) {}
+
+// render renders the current screen. The callback data contains the screen
+// id to detect the presses on the stale screens.
+func (s *Session) render() (tgot.EditText, *tg.InlineKeyboardMarkup, error) {
+	screen := s.Screen()
+	id := s.screenID()
+	text := screen.text(s)
+	s.items = screen.items(s)
+
+	k := NewInline()
+	buttons := make([]tg.InlineKeyboardButton, len(s.items))
+	for i, item := range s.items {
+		if item.button != nil {
+			buttons[i] = *item.button
+			continue
+		}
+		buttons[i] = Callback(item.text(s), menuItem+id+":"+strconv.Itoa(i))
+	}
+	k.Grid(max(1, screen.Columns), buttons...)
+	if len(s.stack) > 1 {
+		k.Row(Callback(cmp.Or(s.menu.BackText, DefaultBackText), menuBack+id))
+	}
+	kb, err := k.Build()
+	return text, kb, err
+}
+
+func (s *Session) screenID() string { return strconv.Itoa(s.stack[len(s.stack)-1].id) }
+
+func (s *Session) show() error {
+	text, kb, err := s.render()
+	if err != nil {
+		return err
+	}
+	_, err = s.Msg.EditText(text, *kb)
+	if IsNotModified(err) {
+		return nil
+	}
+	return err
+}
+
+// Handle implements router.CallbackHandler.
+func (s *Session) Handle(m *tgot.Message, q *tg.CallbackQuery) tgot.CallbackAnswer {
+	s.mut.Lock()
+	defer s.mut.Unlock()
+	s.Msg, s.Query, s.answer = m, q, tgot.CallbackAnswer{}
+
+	s.press(q.Data)
+
+	if s.closed {
+		s.menu.Callbacks.Unregister(m.ID())
+		return s.answer
+	}
+	s.menu.Callbacks.Register(m.ID(), s)
+	if err := s.show(); err != nil && s.answer.Text == "" {
+		s.answer = tgot.CallbackAnswer{Text: "failed to update the menu"}
+	}
+	return s.answer
+}
+
+// press handles the button with the callback data. The presses on the stale
+// screens are ignored, so the current screen is rendered again.
+func (s *Session) press(data string) {
+	id := s.screenID()
+	switch {
+	case data == menuBack+id:
+		s.Back()
+	case strings.HasPrefix(data, menuItem+id+":"):
+		i, err := strconv.Atoi(data[len(menuItem+id+":"):])
+		if err != nil || i < 0 || i >= len(s.items) || s.items[i].press == nil {
+			return
+		}
+		if err = s.items[i].press(s); err != nil {
+			s.answer = tgot.CallbackAnswer{Text: err.Error(), ShowAlert: true}
+		}
+	}
+}
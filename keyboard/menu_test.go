@@ -0,0 +1,72 @@
+package keyboard
+
+import "testing"
+
+func TestMenuNavigation(t *testing.T) {
+	var enabled, deleted bool
+	notifications := &Screen{
+		Title: "notifications",
+		Items: []Item{
+			Toggle("enabled", func(*Session) bool { return enabled }, func(_ *Session, v bool) error {
+				enabled = v
+				return nil
+			}),
+		},
+	}
+	m := &Menu{Root: &Screen{
+		Title: "settings",
+		Items: []Item{
+			Open("notifications", notifications),
+			Confirm("delete", "are you sure?", func(*Session) error { deleted = true; return nil }),
+		},
+	}}
+	s := m.newSession()
+
+	press := func(i int) {
+		t.Helper()
+		if _, _, err := s.render(); err != nil {
+			t.Fatal(err)
+		}
+		if err := s.items[i].press(s); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	press(0)
+	if s.Screen() != notifications {
+		t.Fatal("screen is not opened")
+	}
+	_, kb, _ := s.render()
+	if kb.Keyboard[0][0].Text != "⬜ enabled" || kb.Keyboard[1][0].CallbackData != "mb:1" {
+		t.Fatalf("unexpected keyboard %+v", kb.Keyboard)
+	}
+	press(0)
+	if !enabled {
+		t.Fatal("toggle is not switched")
+	}
+	s.Back()
+
+	// the button of the closed screen at the same depth is ignored
+	stale := kb.Keyboard[0][0].CallbackData
+	press(1)
+	s.render()
+	s.press(stale)
+	if !enabled || s.Screen() == m.Root {
+		t.Fatal("stale button is pressed")
+	}
+	s.Back()
+
+	press(1)
+	if text, _, _ := s.render(); text.Text != "are you sure?" {
+		t.Fatalf("unexpected confirm text %q", text.Text)
+	}
+	press(1)
+	if deleted || s.Screen() != m.Root {
+		t.Fatal("declined action is called")
+	}
+	press(1)
+	press(0)
+	if !deleted || s.Screen() != m.Root {
+		t.Fatal("confirmed action is not called")
+	}
+}
@@ -0,0 +1,313 @@
+package keyboard
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/karalef/tgot"
+	"github.com/karalef/tgot/api"
+	"github.com/karalef/tgot/api/tg"
+	"github.com/karalef/tgot/router"
+)
+
+// default Paginator parameters.
+const (
+	DefaultPageSize     = 10
+	DefaultPagerTimeout = 10 * time.Minute
+)
+
+// Source provides the items for Paginator.
+type Source[T any] interface {
+	// Count returns the total number of items.
+	Count() (int, error)
+
+	// Page returns at most limit items starting from offset.
+	Page(offset, limit int) ([]T, error)
+}
+
+// SliceSource is a Source over the slice.
+type SliceSource[T any] []T
+
+// Count implements Source.
+func (s SliceSource[T]) Count() (int, error) { return len(s), nil }
+
+// Page implements Source.
+func (s SliceSource[T]) Page(offset, limit int) ([]T, error) {
+	offset = min(offset, len(s))
+	return s[offset:min(offset+limit, len(s))], nil
+}
+
+// Page contains the items of the page.
+type Page[T any] struct {
+	Items []T
+
+	// Number is the zero-based page number.
+	Number int
+
+	// Pages is the total number of pages.
+	Pages int
+
+	// Offset is the index of the first item.
+	Offset int
+
+	// Total is the total number of items.
+	Total int
+}
+
+// Paginator renders the items page by page and navigates between the pages
+// by editing the message in place.
+//
+// The items are rendered as text by Render and also as buttons if Button
+// is set.
+type Paginator[T any] struct {
+	Source    Source[T]
+	Callbacks *router.Callbacks
+
+	// PageSize is the number of items on a page.
+	// Default is DefaultPageSize.
+	PageSize int
+
+	// Render renders the page text. If it is nil, the items are rendered as
+	// the numbered list.
+	Render func(Page[T]) tgot.EditText
+
+	// Button renders the item button. If it is nil, the items are rendered
+	// as text only.
+	Button func(item T) string
+
+	// Columns is the number of item buttons in a row. Default is 1.
+	Columns int
+
+	// Select is called when the item button is pressed.
+	Select func(m *tgot.Message, q *tg.CallbackQuery, item T) tgot.CallbackAnswer
+
+	// Timeout specifies how long the navigation works after the last use.
+	// Default is DefaultPagerTimeout.
+	Timeout time.Duration
+
+	// RemoveOnTimeout removes the keyboard when the paginator times out.
+	RemoveOnTimeout bool
+}
+
+// callback data prefixes.
+const (
+	pagerPage = "pg:"
+	pagerItem = "pi:"
+	pagerNoop = "pn"
+)
+
+// Send sends the first page to the chat and starts handling the navigation.
+func (p *Paginator[T]) Send(c *tgot.Chat, opts ...tgot.SendOptions) (*tg.Message, error) {
+	s := p.newState()
+	page, kb, err := s.render(0)
+	if err != nil {
+		return nil, err
+	}
+	msg, err := send(c, page, kb, opts...)
+	if err != nil {
+		return nil, err
+	}
+	p.Callbacks.Register(tgot.ChatMsgID(msg), s)
+	return msg, nil
+}
+
+// Show shows the page by editing the message and starts handling the
+// navigation. It can be used for inline messages.
+func (p *Paginator[T]) Show(m *tgot.Message, page int) error {
+	s := p.newState()
+	if err := s.show(m, page); err != nil {
+		return err
+	}
+	p.Callbacks.Register(m.ID(), s)
+	return nil
+}
+
+func (p *Paginator[T]) newState() *pagerState[T] {
+	return &pagerState[T]{p: p}
+}
+
+func (p *Paginator[T]) pageSize() int {
+	if p.PageSize > 0 {
+		return p.PageSize
+	}
+	return DefaultPageSize
+}
+
+func (p *Paginator[T]) timeout() time.Duration {
+	if p.Timeout > 0 {
+		return p.Timeout
+	}
+	return DefaultPagerTimeout
+}
+
+func (p *Paginator[T]) render(page Page[T]) tgot.EditText {
+	if p.Render != nil {
+		return p.Render(page)
+	}
+	var sb strings.Builder
+	for i, item := range page.Items {
+		fmt.Fprintf(&sb, "%d. %v\n", page.Offset+i+1, item)
+	}
+	if len(page.Items) == 0 {
+		sb.WriteString("empty\n")
+	}
+	fmt.Fprintf(&sb, "\npage %d/%d", page.Number+1, page.Pages)
+	return tgot.EditText{Text: sb.String()}
+}
+
+var _ router.CallbackHandler = &pagerState[struct{}]{}
+
+// pagerState is the state of the paginated message.
+type pagerState[T any] struct {
+	p *Paginator[T]
+
+	mut  sync.Mutex
+	page Page[T]
+
+	// version is incremented on each render, so the item buttons of the
+	// replaced keyboard are detected.
+	version int
+}
+
+func (s *pagerState[T]) Name() string       { return "paginator" }
+func (s *pagerState[T]) Timeout() time.Time { return time.Now().Add(s.p.timeout()) }
+
+func (s *pagerState[T]) Cancel(ctx tgot.BaseContext, sig tgot.MessageID) {
+	if s.p.RemoveOnTimeout {
+		tgot.WithMessage(ctx, sig).EditReplyMarkup(nil)
+	}
+}
+
+func (s *pagerState[T]) OnError(tgot.Query[tgot.CallbackAnswer], error) {}
+
+// render fetches the page and renders it. The page number is clamped to the
+// existing pages, so the stale page shows the nearest one.
+func (s *pagerState[T]) render(number int) (tgot.EditText, *tg.InlineKeyboardMarkup, error) {
+	total, err := s.p.Source.Count()
+	if err != nil {
+		return tgot.EditText{}, nil, err
+	}
+	size := s.p.pageSize()
+	pages := max(1, (total+size-1)/size)
+	number = max(0, min(number, pages-1))
+	items, err := s.p.Source.Page(number*size, size)
+	if err != nil {
+		return tgot.EditText{}, nil, err
+	}
+	s.page = Page[T]{
+		Items:  items,
+		Number: number,
+		Pages:  pages,
+		Offset: number * size,
+		Total:  total,
+	}
+	s.version++
+	kb, err := s.keyboard()
+	return s.p.render(s.page), kb, err
+}
+
+func (s *pagerState[T]) keyboard() (*tg.InlineKeyboardMarkup, error) {
+	k := NewInline()
+	if s.p.Button != nil {
+		cols := max(1, s.p.Columns)
+		buttons := make([]tg.InlineKeyboardButton, len(s.page.Items))
+		for i, item := range s.page.Items {
+			data := pagerItem + strconv.Itoa(s.version) + ":" + strconv.Itoa(i)
+			buttons[i] = Callback(s.p.Button(item), data)
+		}
+		k.Grid(cols, buttons...)
+	}
+	if s.page.Pages > 1 {
+		var nav []tg.InlineKeyboardButton
+		if s.page.Number > 0 {
+			nav = append(nav, Callback("‹", pagerPage+strconv.Itoa(s.page.Number-1)))
+		}
+		counter := strconv.Itoa(s.page.Number+1) + "/" + strconv.Itoa(s.page.Pages)
+		nav = append(nav, Callback(counter, pagerNoop))
+		if s.page.Number < s.page.Pages-1 {
+			nav = append(nav, Callback("›", pagerPage+strconv.Itoa(s.page.Number+1)))
+		}
+		k.Row(nav...)
+	}
+	return k.Build()
+}
+
+func (s *pagerState[T]) show(m *tgot.Message, number int) error {
+	s.mut.Lock()
+	defer s.mut.Unlock()
+	page, kb, err := s.render(number)
+	if err != nil {
+		return err
+	}
+	_, err = m.EditText(page, *kb)
+	if IsNotModified(err) {
+		return nil
+	}
+	return err
+}
+
+func (s *pagerState[T]) Handle(m *tgot.Message, q *tg.CallbackQuery) tgot.CallbackAnswer {
+	defer s.p.Callbacks.Register(m.ID(), s)
+
+	switch data := q.Data; {
+	case strings.HasPrefix(data, pagerPage):
+		n, err := strconv.Atoi(data[len(pagerPage):])
+		if err != nil {
+			return tgot.CallbackAnswer{}
+		}
+		if err = s.show(m, n); err != nil {
+			return tgot.CallbackAnswer{Text: "failed to load the page"}
+		}
+	case strings.HasPrefix(data, pagerItem):
+		item, ok, page := s.item(data[len(pagerItem):])
+		if !ok {
+			if err := s.show(m, page); err != nil {
+				return tgot.CallbackAnswer{Text: "failed to load the page"}
+			}
+			return tgot.CallbackAnswer{Text: "the list has changed, try again"}
+		}
+		if s.p.Select != nil {
+			return s.p.Select(m, q, item)
+		}
+	}
+	return tgot.CallbackAnswer{}
+}
+
+// item returns the item of the button with the data in format
+// "version:index". If the button belongs to the replaced keyboard, ok is
+// false and page is the current page number.
+func (s *pagerState[T]) item(data string) (item T, ok bool, page int) {
+	version, index, _ := strings.Cut(data, ":")
+	v, err := strconv.Atoi(version)
+	i := -1
+	if err == nil {
+		i, err = strconv.Atoi(index)
+	}
+	s.mut.Lock()
+	defer s.mut.Unlock()
+	if err != nil || v != s.version || i < 0 || i >= len(s.page.Items) {
+		return item, false, s.page.Number
+	}
+	return s.page.Items[i], true, s.page.Number
+}
+
+func send(c *tgot.Chat, t tgot.EditText, kb *tg.InlineKeyboardMarkup, opts ...tgot.SendOptions) (*tg.Message, error) {
+	return c.Send(tgot.Text{
+		Text:               t.Text,
+		ParseMode:          t.ParseMode,
+		Entities:           t.Entities,
+		LinkPreviewOptions: t.LinkPreviewOptions,
+		ReplyMarkup:        kb,
+	}, opts...)
+}
+
+// IsNotModified reports whether the error is returned because the edited
+// message is not modified.
+func IsNotModified(err error) bool {
+	var apiErr *api.Error
+	return errors.As(err, &apiErr) && strings.Contains(apiErr.Err.Description, "message is not modified")
+}
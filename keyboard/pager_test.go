@@ -0,0 +1,64 @@
+package keyboard
+
+import (
+	"slices"
+	"strconv"
+	"strings"
+	"testing"
+)
+
+func TestPagerRender(t *testing.T) {
+	src := SliceSource[int]{1, 2, 3, 4, 5}
+	p := &Paginator[int]{Source: src, PageSize: 2}
+	s := p.newState()
+
+	text, kb, err := s.render(1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if text.Text != "3. 3\n4. 4\n\npage 2/3" {
+		t.Fatalf("unexpected text %q", text.Text)
+	}
+	expected := [][]string{{"‹", "2/3", "›"}}
+	if got := texts(kb.Keyboard); !equal(got, expected) {
+		t.Fatalf("expected %v, got %v", expected, got)
+	}
+
+	// the stale page is clamped to the last one
+	p.Source = src[:3]
+	if _, kb, err = s.render(2); err != nil {
+		t.Fatal(err)
+	}
+	if s.page.Number != 1 || !slices.Equal(s.page.Items, []int{3}) {
+		t.Fatalf("unexpected page %+v", s.page)
+	}
+	if kb.Keyboard[0][0].CallbackData != "pg:0" {
+		t.Fatalf("unexpected keyboard %+v", kb.Keyboard)
+	}
+}
+
+func TestPagerItem(t *testing.T) {
+	p := &Paginator[int]{
+		Source:   SliceSource[int]{1, 2, 3},
+		PageSize: 2,
+		Button:   func(item int) string { return strconv.Itoa(item) },
+	}
+	s := p.newState()
+	_, kb, err := s.render(0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	data := kb.Keyboard[0][0].CallbackData
+	if item, ok, _ := s.item(strings.TrimPrefix(data, pagerItem)); !ok || item != 1 {
+		t.Fatalf("unexpected item %d for %q", item, data)
+	}
+
+	// the same page is rendered again with the changed items
+	p.Source = SliceSource[int]{0, 1, 2, 3}
+	if _, _, err = s.render(0); err != nil {
+		t.Fatal(err)
+	}
+	if _, ok, page := s.item(strings.TrimPrefix(data, pagerItem)); ok || page != 0 {
+		t.Fatalf("the button of the replaced keyboard is accepted")
+	}
+}
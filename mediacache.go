@@ -0,0 +1,208 @@
+package tgot
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"io"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/karalef/tgot/api"
+	"github.com/karalef/tgot/api/tg"
+)
+
+// MediaStore persists the file IDs of the uploaded files.
+type MediaStore interface {
+	Load(key string) (fileID string, ok bool)
+	Store(key, fileID string)
+	Delete(key string)
+}
+
+// MemoryMediaStore is an in-memory MediaStore.
+// The zero value is ready to use.
+type MemoryMediaStore struct {
+	mut sync.RWMutex
+	ids map[string]string
+}
+
+// Load returns the file ID stored by the key.
+func (s *MemoryMediaStore) Load(key string) (string, bool) {
+	s.mut.RLock()
+	defer s.mut.RUnlock()
+	id, ok := s.ids[key]
+	return id, ok
+}
+
+// Store stores the file ID by the key.
+func (s *MemoryMediaStore) Store(key, fileID string) {
+	s.mut.Lock()
+	defer s.mut.Unlock()
+	if s.ids == nil {
+		s.ids = make(map[string]string)
+	}
+	s.ids[key] = fileID
+}
+
+// Delete deletes the file ID stored by the key.
+func (s *MemoryMediaStore) Delete(key string) {
+	s.mut.Lock()
+	defer s.mut.Unlock()
+	delete(s.ids, key)
+}
+
+// MediaCache caches the file IDs of the uploaded local files, so the same
+// file is uploaded only once and then sent by its file ID.
+//
+// The os.File inputs are identified by the path, modification time and size,
+// the other inputs by the content hash. The path-based key is best-effort:
+// a file replaced by another one with the same path, size and modification
+// time is not detected. The files are cached separately for each send method,
+// since Telegram assigns different IDs to the same file sent as a photo and
+// as a document.
+//
+// The zero value is ready to use.
+type MediaCache struct {
+	// Store is used to persist the file IDs.
+	Store MediaStore // default: in-memory store
+
+	once sync.Once
+}
+
+func (c *MediaCache) store() MediaStore {
+	c.once.Do(func() {
+		if c.Store == nil {
+			c.Store = new(MemoryMediaStore)
+		}
+	})
+	return c.Store
+}
+
+// Send sends the Sendable substituting the cached file ID for the local file.
+// If the file is not cached yet, it is uploaded and the file ID from the
+// sent message is cached. If Telegram rejects the cached file ID, it is
+// deleted and the file is uploaded again.
+//
+// The sendables other than Photo, Audio, Document, Video, Animation, Voice,
+// VideoNote and Sticker are sent as is.
+//
+// The file data that is not an os.File or an io.Seeker is read entirely into
+// memory to compute the hash and the InputFile is changed to read from the
+// buffer. If the reading fails, the InputFile still reads the whole data
+// up to the error.
+func (c *MediaCache) Send(chat *Chat, s Sendable, opts ...SendOptions) (*tg.Message, error) {
+	u, ok := s.(uploadable)
+	if !ok {
+		return chat.Send(s, opts...)
+	}
+	f, ok := u.media().(*tg.InputFile)
+	if !ok || f == nil {
+		return chat.Send(s, opts...)
+	}
+	key, err := mediaKey(s.sendMethod(), f)
+	if err != nil {
+		return nil, err
+	}
+
+	store := c.store()
+	if id, ok := store.Load(key); ok {
+		msg, err := chat.Send(u.withMedia(tg.FileID(id)), opts...)
+		if !isStaleFileID(err) {
+			return msg, err
+		}
+		store.Delete(key)
+	}
+
+	msg, err := chat.Send(s, opts...)
+	if err != nil {
+		return nil, err
+	}
+	if id := sentFileID(s.sendMethod(), msg); id != "" {
+		store.Store(key, id)
+	}
+	return msg, nil
+}
+
+// mediaKey returns the cache key of the file.
+// The data is read to compute the hash and replaced
+// with the buffered reader if it is not seekable.
+// If the reading fails, the read part is put back in front of the data.
+func mediaKey(method string, f *tg.InputFile) (string, error) {
+	if file, ok := f.Data.(*os.File); ok {
+		if fi, err := file.Stat(); err == nil && fi.Mode().IsRegular() {
+			path, err := filepath.Abs(file.Name())
+			if err != nil {
+				return "", err
+			}
+			return method + ":file:" + path + ":" +
+				strconv.FormatInt(fi.ModTime().UnixNano(), 10) + ":" +
+				strconv.FormatInt(fi.Size(), 10), nil
+		}
+	}
+
+	h := sha256.New()
+	if rs, ok := f.Data.(io.ReadSeeker); ok {
+		if start, err := rs.Seek(0, io.SeekCurrent); err == nil {
+			if _, err = io.Copy(h, rs); err != nil {
+				return "", err
+			}
+			if _, err = rs.Seek(start, io.SeekStart); err != nil {
+				return "", err
+			}
+			return method + ":sha256:" + hex.EncodeToString(h.Sum(nil)), nil
+		}
+	}
+	var buf bytes.Buffer
+	if _, err := io.Copy(io.MultiWriter(h, &buf), f.Data); err != nil {
+		f.Data = io.MultiReader(&buf, f.Data)
+		return "", err
+	}
+	f.Data = bytes.NewReader(buf.Bytes())
+	return method + ":sha256:" + hex.EncodeToString(h.Sum(nil)), nil
+}
+
+// sentFileID returns the ID of the file sent with the method.
+func sentFileID(method string, msg *tg.Message) string {
+	switch {
+	case msg == nil:
+	case method == "sendPhoto" && len(msg.Photo) > 0:
+		largest := msg.Photo[0]
+		for _, p := range msg.Photo[1:] {
+			if p.Width*p.Height > largest.Width*largest.Height {
+				largest = p
+			}
+		}
+		return largest.FileID
+	case method == "sendAudio" && msg.Audio != nil:
+		return msg.Audio.FileID
+	case method == "sendDocument" && msg.Document != nil:
+		return msg.Document.FileID
+	case method == "sendVideo" && msg.Video != nil:
+		return msg.Video.FileID
+	case method == "sendAnimation" && msg.Animation != nil:
+		return msg.Animation.FileID
+	case method == "sendVoice" && msg.Voice != nil:
+		return msg.Voice.FileID
+	case method == "sendVideoNote" && msg.VideoNote != nil:
+		return msg.VideoNote.FileID
+	case method == "sendSticker" && msg.Sticker != nil:
+		return msg.Sticker.FileID
+	}
+	return ""
+}
+
+// isStaleFileID reports whether Telegram rejected the file ID.
+func isStaleFileID(err error) bool {
+	var apiErr *api.Error
+	if !errors.As(err, &apiErr) || apiErr.Err.Code != 400 {
+		return false
+	}
+	desc := strings.ToLower(apiErr.Err.Description)
+	return strings.Contains(desc, "file identifier") ||
+		strings.Contains(desc, "file_reference") ||
+		strings.Contains(desc, "file reference")
+}
@@ -0,0 +1,88 @@
+package tgot
+
+import (
+	stdcontext "context"
+	"crypto/sha256"
+	"encoding/hex"
+	"io"
+	"net/http"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"testing/iotest"
+
+	"github.com/karalef/tgot/api/tg"
+)
+
+type photoServer struct {
+	uploads atomic.Int32
+	stale   atomic.Bool
+}
+
+func (s *photoServer) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if strings.HasSuffix(r.URL.Path, "/getMe") {
+		io.WriteString(w, `{"ok":true,"result":{"id":1,"is_bot":true,"first_name":"bot"}}`)
+		return
+	}
+	if err := r.ParseMultipartForm(1 << 20); err != nil {
+		r.ParseForm()
+	}
+	if r.MultipartForm != nil && len(r.MultipartForm.File["photo"]) > 0 {
+		s.uploads.Add(1)
+	} else if r.FormValue("photo") != "large" || s.stale.Load() {
+		io.WriteString(w, `{"ok":false,"error_code":400,`+
+			`"description":"Bad Request: wrong file identifier/HTTP URL specified"}`)
+		return
+	}
+	io.WriteString(w, `{"ok":true,"result":{"message_id":1,"date":0,"chat":{"id":1,"type":"private"},`+
+		`"photo":[{"file_id":"small","width":90,"height":90},{"file_id":"large","width":800,"height":800}]}}`)
+}
+
+func TestMediaCache(t *testing.T) {
+	srv := new(photoServer)
+	ctx := testBot(t, srv).NewContext(stdcontext.Background(), "test")
+	chat := WithChatID(ctx, NewChatID(tg.ID(1)))
+
+	var cache MediaCache
+	send := func() {
+		t.Helper()
+		// the same content in different readers
+		photo := NewPhoto(tg.FileReader("photo.jpg", io.LimitReader(strings.NewReader("photo data"), 100)))
+		if _, err := cache.Send(chat, photo); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	send()
+	send()
+	if n := srv.uploads.Load(); n != 1 {
+		t.Fatalf("expected 1 upload, got %d", n)
+	}
+
+	// the cached ID is rejected
+	srv.stale.Store(true)
+	send()
+	if n := srv.uploads.Load(); n != 2 {
+		t.Fatalf("expected the stale ID to be reuploaded, got %d uploads", n)
+	}
+	if id, _ := cache.Store.Load("sendPhoto:sha256:" + sha256Hex("photo data")); id != "large" {
+		t.Fatalf("expected the largest photo to be cached, got %q", id)
+	}
+}
+
+func sha256Hex(s string) string {
+	h := sha256.Sum256([]byte(s))
+	return hex.EncodeToString(h[:])
+}
+
+func TestMediaKeyReadError(t *testing.T) {
+	const data = "first chunk and the rest"
+	f := &tg.InputFile{Data: iotest.TimeoutReader(iotest.OneByteReader(strings.NewReader(data)))}
+	if _, err := mediaKey("sendPhoto", f); err != iotest.ErrTimeout {
+		t.Fatalf("expected timeout error, got %v", err)
+	}
+	b, err := io.ReadAll(f.Data)
+	if err != nil || string(b) != data {
+		t.Fatalf("data is lost after the failed read: %q, %v", b, err)
+	}
+}
@@ -0,0 +1,262 @@
+package tgot
+
+import "github.com/karalef/tgot/api/tg"
+
+// MessageRouter dispatches messages to the typed handlers by the message kind.
+// It can be used as [Router.OnMessage] or any other message handler.
+//
+// Each message is passed to the only handler that matches its kind.
+// If the handler for the message kind is nil, the message is passed to OnOther.
+type MessageRouter struct {
+	// content messages
+	OnText      func(*Message, *tg.Message, string)
+	OnAnimation func(*Message, *tg.Message, *tg.Animation)
+	OnAudio     func(*Message, *tg.Message, *tg.Audio)
+	OnDocument  func(*Message, *tg.Message, *tg.Document)
+	OnPaidMedia func(*Message, *tg.Message, *tg.PaidMediaInfo)
+	OnPhoto     func(*Message, *tg.Message, []tg.PhotoSize)
+	OnSticker   func(*Message, *tg.Message, *tg.Sticker)
+	OnStory     func(*Message, *tg.Message, *tg.Story)
+	OnVideo     func(*Message, *tg.Message, *tg.Video)
+	OnVideoNote func(*Message, *tg.Message, *tg.VideoNote)
+	OnVoice     func(*Message, *tg.Message, *tg.Voice)
+	OnChecklist func(*Message, *tg.Message, *tg.Checklist)
+	OnContact   func(*Message, *tg.Message, *tg.Contact)
+	OnDice      func(*Message, *tg.Message, *tg.Dice)
+	OnGame      func(*Message, *tg.Message, *tg.Game)
+	OnPoll      func(*Message, *tg.Message, *tg.Poll)
+	OnVenue     func(*Message, *tg.Message, *tg.Venue)
+	OnLocation  func(*Message, *tg.Message, *tg.Location)
+	OnInvoice   func(*Message, *tg.Message, *tg.Invoice)
+
+	// service messages
+	OnNewMembers                func(*Message, *tg.Message, []*tg.User)
+	OnLeftMember                func(*Message, *tg.Message, *tg.User)
+	OnNewChatTitle              func(*Message, *tg.Message, string)
+	OnNewChatPhoto              func(*Message, *tg.Message, []tg.PhotoSize)
+	OnDeleteChatPhoto           func(*Message, *tg.Message)
+	OnGroupCreated              func(*Message, *tg.Message)
+	OnSuperGroupCreated         func(*Message, *tg.Message)
+	OnChannelCreated            func(*Message, *tg.Message)
+	OnAutoDeleteTimerChanged    func(*Message, *tg.Message, *tg.AutoDeleteTimer)
+	OnMigrateTo                 func(*Message, *tg.Message, tg.ID)
+	OnMigrateFrom               func(*Message, *tg.Message, tg.ID)
+	OnPinnedMessage             func(*Message, *tg.Message, *tg.MaybeInaccessibleMessage)
+	OnSuccessfulPayment         func(*Message, *tg.Message, *tg.SuccessfulPayment)
+	OnRefundedPayment           func(*Message, *tg.Message, *tg.RefundedPayment)
+	OnUsersShared               func(*Message, *tg.Message, *tg.UsersShared)
+	OnChatShared                func(*Message, *tg.Message, *tg.ChatShared)
+	OnGift                      func(*Message, *tg.Message, *tg.GiftInfo)
+	OnUniqueGift                func(*Message, *tg.Message, *tg.UniqueGiftInfo)
+	OnConnectedWebsite          func(*Message, *tg.Message, string)
+	OnPassportData              func(*Message, *tg.Message, *tg.PassportData)
+	OnProximityAlert            func(*Message, *tg.Message, *tg.ProximityAlert)
+	OnBoostAdded                func(*Message, *tg.Message, *tg.ChatBoostAdded)
+	OnChatBackgroundSet         func(*Message, *tg.Message, *tg.ChatBackground)
+	OnChecklistTasksDone        func(*Message, *tg.Message, *tg.ChecklistTasksDone)
+	OnChecklistTasksAdded       func(*Message, *tg.Message, *tg.ChecklistTasksAdded)
+	OnDirectMessagePriceChanged func(*Message, *tg.Message, *tg.DirectMessagePriceChanged)
+	OnForumTopicCreated         func(*Message, *tg.Message, *tg.ForumTopicCreated)
+	OnForumTopicEdited          func(*Message, *tg.Message, *tg.ForumTopicEdited)
+	OnForumTopicClosed          func(*Message, *tg.Message, *tg.ForumTopicClosed)
+	OnForumTopicReopened        func(*Message, *tg.Message, *tg.ForumTopicReopened)
+	OnGeneralForumTopicHidden   func(*Message, *tg.Message, *tg.GeneralForumTopicHidden)
+	OnGeneralForumTopicUnhidden func(*Message, *tg.Message, *tg.GeneralForumTopicUnhidden)
+	OnGiveawayCreated           func(*Message, *tg.Message, *tg.GiveawayCreated)
+	OnGiveaway                  func(*Message, *tg.Message, *tg.Giveaway)
+	OnGiveawayWinners           func(*Message, *tg.Message, *tg.GiveawayWinners)
+	OnGiveawayCompleted         func(*Message, *tg.Message, *tg.GiveawayCompleted)
+	OnPaidMessagePriceChanged   func(*Message, *tg.Message, *tg.PaidMessagePriceChanged)
+	OnVideoChatScheduled        func(*Message, *tg.Message, *tg.VideoChatScheduled)
+	OnVideoChatStarted          func(*Message, *tg.Message, *tg.VideoChatStarted)
+	OnVideoChatEnded            func(*Message, *tg.Message, *tg.VideoChatEnded)
+	OnVideoChatInvited          func(*Message, *tg.Message, *tg.VideoChatInvited)
+	OnWebAppData                func(*Message, *tg.Message, *tg.WebAppData)
+
+	// OnOther is called for messages that were not handled by the typed handlers.
+	OnOther func(*Message, *tg.Message)
+}
+
+func on[T any](h func(*Message, *tg.Message, T), ctx *Message, msg *tg.Message, v T) bool {
+	if h == nil {
+		return false
+	}
+	h(ctx, msg, v)
+	return true
+}
+
+func onService(h func(*Message, *tg.Message), ctx *Message, msg *tg.Message) bool {
+	if h == nil {
+		return false
+	}
+	h(ctx, msg)
+	return true
+}
+
+// Handle dispatches the message to the handler that matches its kind.
+func (r *MessageRouter) Handle(ctx *Message, msg *tg.Message) {
+	if !r.handle(ctx, msg) && r.OnOther != nil {
+		r.OnOther(ctx, msg)
+	}
+}
+
+func (r *MessageRouter) handle(ctx *Message, msg *tg.Message) bool {
+	switch {
+	case msg.Text != "":
+		return on(r.OnText, ctx, msg, msg.Text)
+	case msg.Animation != nil:
+		return on(r.OnAnimation, ctx, msg, msg.Animation)
+	case msg.Audio != nil:
+		return on(r.OnAudio, ctx, msg, msg.Audio)
+	case msg.Document != nil:
+		return on(r.OnDocument, ctx, msg, msg.Document)
+	case msg.PaidMedia != nil:
+		return on(r.OnPaidMedia, ctx, msg, msg.PaidMedia)
+	case len(msg.Photo) > 0:
+		return on(r.OnPhoto, ctx, msg, msg.Photo)
+	case msg.Sticker != nil:
+		return on(r.OnSticker, ctx, msg, msg.Sticker)
+	case msg.Story != nil:
+		return on(r.OnStory, ctx, msg, msg.Story)
+	case msg.Video != nil:
+		return on(r.OnVideo, ctx, msg, msg.Video)
+	case msg.VideoNote != nil:
+		return on(r.OnVideoNote, ctx, msg, msg.VideoNote)
+	case msg.Voice != nil:
+		return on(r.OnVoice, ctx, msg, msg.Voice)
+	case msg.Checklist != nil:
+		return on(r.OnChecklist, ctx, msg, msg.Checklist)
+	case msg.Contact != nil:
+		return on(r.OnContact, ctx, msg, msg.Contact)
+	case msg.Dice != nil:
+		return on(r.OnDice, ctx, msg, msg.Dice)
+	case msg.Game != nil:
+		return on(r.OnGame, ctx, msg, msg.Game)
+	case msg.Poll != nil:
+		return on(r.OnPoll, ctx, msg, msg.Poll)
+	case msg.Venue != nil:
+		return on(r.OnVenue, ctx, msg, msg.Venue)
+	case msg.Location != nil:
+		return on(r.OnLocation, ctx, msg, msg.Location)
+	case msg.Invoice != nil:
+		return on(r.OnInvoice, ctx, msg, msg.Invoice)
+	case len(msg.NewChatMembers) > 0:
+		return on(r.OnNewMembers, ctx, msg, msg.NewChatMembers)
+	case msg.LeftChatMember != nil:
+		return on(r.OnLeftMember, ctx, msg, msg.LeftChatMember)
+	case msg.NewChatTitle != "":
+		return on(r.OnNewChatTitle, ctx, msg, msg.NewChatTitle)
+	case len(msg.NewChatPhoto) > 0:
+		return on(r.OnNewChatPhoto, ctx, msg, msg.NewChatPhoto)
+	case msg.DeleteChatPhoto:
+		return onService(r.OnDeleteChatPhoto, ctx, msg)
+	case msg.GroupCreated:
+		return onService(r.OnGroupCreated, ctx, msg)
+	case msg.SuperGroupCreated:
+		return onService(r.OnSuperGroupCreated, ctx, msg)
+	case msg.ChannelCreated:
+		return onService(r.OnChannelCreated, ctx, msg)
+	case msg.AutoDeleteTimerChanged != nil:
+		return on(r.OnAutoDeleteTimerChanged, ctx, msg, msg.AutoDeleteTimerChanged)
+	case msg.MigrateTo != 0:
+		return on(r.OnMigrateTo, ctx, msg, msg.MigrateTo)
+	case msg.MigrateFrom != 0:
+		return on(r.OnMigrateFrom, ctx, msg, msg.MigrateFrom)
+	case msg.PinnedMessage != nil:
+		return on(r.OnPinnedMessage, ctx, msg, msg.PinnedMessage)
+	case msg.SuccessfulPayment != nil:
+		return on(r.OnSuccessfulPayment, ctx, msg, msg.SuccessfulPayment)
+	case msg.RefundedPayment != nil:
+		return on(r.OnRefundedPayment, ctx, msg, msg.RefundedPayment)
+	case msg.UsersShared != nil:
+		return on(r.OnUsersShared, ctx, msg, msg.UsersShared)
+	case msg.ChatShared != nil:
+		return on(r.OnChatShared, ctx, msg, msg.ChatShared)
+	case msg.Gift != nil:
+		return on(r.OnGift, ctx, msg, msg.Gift)
+	case msg.UniqueGift != nil:
+		return on(r.OnUniqueGift, ctx, msg, msg.UniqueGift)
+	case msg.ConnectedWebsite != "":
+		return on(r.OnConnectedWebsite, ctx, msg, msg.ConnectedWebsite)
+	case msg.PassportData != nil:
+		return on(r.OnPassportData, ctx, msg, msg.PassportData)
+	case msg.ProximityAlert != nil:
+		return on(r.OnProximityAlert, ctx, msg, msg.ProximityAlert)
+	case msg.BoostAdded != nil:
+		return on(r.OnBoostAdded, ctx, msg, msg.BoostAdded)
+	case msg.ChatBackgroundSet != nil:
+		return on(r.OnChatBackgroundSet, ctx, msg, msg.ChatBackgroundSet)
+	case msg.ChecklistTasksDone != nil:
+		return on(r.OnChecklistTasksDone, ctx, msg, msg.ChecklistTasksDone)
+	case msg.ChecklistTasksAdded != nil:
+		return on(r.OnChecklistTasksAdded, ctx, msg, msg.ChecklistTasksAdded)
+	case msg.DirectMessagePriceChanged != nil:
+		return on(r.OnDirectMessagePriceChanged, ctx, msg, msg.DirectMessagePriceChanged)
+	case msg.ForumTopicCreated != nil:
+		return on(r.OnForumTopicCreated, ctx, msg, msg.ForumTopicCreated)
+	case msg.ForumTopicEdited != nil:
+		return on(r.OnForumTopicEdited, ctx, msg, msg.ForumTopicEdited)
+	case msg.ForumTopicClosed != nil:
+		return on(r.OnForumTopicClosed, ctx, msg, msg.ForumTopicClosed)
+	case msg.ForumTopicReopened != nil:
+		return on(r.OnForumTopicReopened, ctx, msg, msg.ForumTopicReopened)
+	case msg.GeneralForumTopicHidden != nil:
+		return on(r.OnGeneralForumTopicHidden, ctx, msg, msg.GeneralForumTopicHidden)
+	case msg.GeneralForumTopicUnhidden != nil:
+		return on(r.OnGeneralForumTopicUnhidden, ctx, msg, msg.GeneralForumTopicUnhidden)
+	case msg.GiveawayCreated != nil:
+		return on(r.OnGiveawayCreated, ctx, msg, msg.GiveawayCreated)
+	case msg.Giveaway != nil:
+		return on(r.OnGiveaway, ctx, msg, msg.Giveaway)
+	case msg.GiveawayWinners != nil:
+		return on(r.OnGiveawayWinners, ctx, msg, msg.GiveawayWinners)
+	case msg.GiveawayCompleted != nil:
+		return on(r.OnGiveawayCompleted, ctx, msg, msg.GiveawayCompleted)
+	case msg.PaidMessagePriceChanged != nil:
+		return on(r.OnPaidMessagePriceChanged, ctx, msg, msg.PaidMessagePriceChanged)
+	case msg.VideoChatScheduled != nil:
+		return on(r.OnVideoChatScheduled, ctx, msg, msg.VideoChatScheduled)
+	case msg.VideoChatStarted != nil:
+		return on(r.OnVideoChatStarted, ctx, msg, msg.VideoChatStarted)
+	case msg.VideoChatEnded != nil:
+		return on(r.OnVideoChatEnded, ctx, msg, msg.VideoChatEnded)
+	case msg.VideoChatInvited != nil:
+		return on(r.OnVideoChatInvited, ctx, msg, msg.VideoChatInvited)
+	case msg.WebAppData != nil:
+		return on(r.OnWebAppData, ctx, msg, msg.WebAppData)
+	}
+	return false
+}
+
+// ByChatType dispatches messages to the handlers by the chat type.
+// It can be used as [Router.OnMessage] or any other message handler.
+type ByChatType struct {
+	Private func(*Message, *tg.Message)
+
+	// Group handles messages from the groups.
+	// It also handles messages from the supergroups if SuperGroup is nil.
+	Group      func(*Message, *tg.Message)
+	SuperGroup func(*Message, *tg.Message)
+	Channel    func(*Message, *tg.Message)
+}
+
+// Handle dispatches the message to the handler that matches the chat type.
+func (c *ByChatType) Handle(ctx *Message, msg *tg.Message) {
+	var h func(*Message, *tg.Message)
+	switch msg.Chat.Type {
+	case tg.ChatPrivate:
+		h = c.Private
+	case tg.ChatGroup:
+		h = c.Group
+	case tg.ChatSuperGroup:
+		h = c.SuperGroup
+		if h == nil {
+			h = c.Group
+		}
+	case tg.ChatChannel:
+		h = c.Channel
+	}
+	if h != nil {
+		h(ctx, msg)
+	}
+}
@@ -0,0 +1,91 @@
+package tgot
+
+import (
+	"testing"
+
+	"github.com/karalef/tgot/api/tg"
+)
+
+func TestMessageRouter(t *testing.T) {
+	var got string
+	record := func(name string) func(*Message, *tg.Message) {
+		return func(*Message, *tg.Message) { got = name }
+	}
+	r := &MessageRouter{
+		OnText:            func(*Message, *tg.Message, string) { got = "text" },
+		OnAnimation:       func(*Message, *tg.Message, *tg.Animation) { got = "animation" },
+		OnDocument:        func(*Message, *tg.Message, *tg.Document) { got = "document" },
+		OnPhoto:           func(*Message, *tg.Message, []tg.PhotoSize) { got = "photo" },
+		OnVenue:           func(*Message, *tg.Message, *tg.Venue) { got = "venue" },
+		OnLocation:        func(*Message, *tg.Message, *tg.Location) { got = "location" },
+		OnNewMembers:      func(*Message, *tg.Message, []*tg.User) { got = "new members" },
+		OnDeleteChatPhoto: record("delete chat photo"),
+		OnOther:           record("other"),
+	}
+	noAnimation := *r
+	noAnimation.OnAnimation = nil
+
+	tests := []struct {
+		name     string
+		router   *MessageRouter
+		msg      tg.Message
+		expected string
+	}{
+		{"text", r, tg.Message{Text: "text"}, "text"},
+		{"animation before document", r, tg.Message{Animation: &tg.Animation{}, Document: &tg.Document{}}, "animation"},
+		{"document", r, tg.Message{Document: &tg.Document{}}, "document"},
+		{"unhandled kind", &noAnimation, tg.Message{Animation: &tg.Animation{}, Document: &tg.Document{}}, "other"},
+		{"photo", r, tg.Message{Photo: []tg.PhotoSize{{}}, Caption: "caption"}, "photo"},
+		{"venue before location", r, tg.Message{Venue: &tg.Venue{}, Location: &tg.Location{}}, "venue"},
+		{"location", r, tg.Message{Location: &tg.Location{}}, "location"},
+		{"new members", r, tg.Message{NewChatMembers: []*tg.User{{}}}, "new members"},
+		{"service", r, tg.Message{DeleteChatPhoto: true}, "delete chat photo"},
+		{"empty", r, tg.Message{}, "other"},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			got = ""
+			test.router.Handle(nil, &test.msg)
+			if got != test.expected {
+				t.Fatalf("expected %q, got %q", test.expected, got)
+			}
+		})
+	}
+}
+
+func TestByChatType(t *testing.T) {
+	var got string
+	record := func(name string) func(*Message, *tg.Message) {
+		return func(*Message, *tg.Message) { got = name }
+	}
+	full := &ByChatType{
+		Private:    record("private"),
+		Group:      record("group"),
+		SuperGroup: record("supergroup"),
+		Channel:    record("channel"),
+	}
+	groups := &ByChatType{Group: record("group")}
+
+	tests := []struct {
+		name     string
+		router   *ByChatType
+		chat     tg.ChatType
+		expected string
+	}{
+		{"private", full, tg.ChatPrivate, "private"},
+		{"group", full, tg.ChatGroup, "group"},
+		{"supergroup", full, tg.ChatSuperGroup, "supergroup"},
+		{"channel", full, tg.ChatChannel, "channel"},
+		{"supergroup fallback", groups, tg.ChatSuperGroup, "group"},
+		{"no handler", groups, tg.ChatPrivate, ""},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			got = ""
+			test.router.Handle(nil, &tg.Message{Chat: &tg.Chat{Type: test.chat}})
+			if got != test.expected {
+				t.Fatalf("expected %q, got %q", test.expected, got)
+			}
+		})
+	}
+}
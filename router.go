@@ -5,6 +5,8 @@ import "github.com/karalef/tgot/api/tg"
 var _ Handler = (*Router)(nil)
 
 // Router contains all available updates handler functions.
+//
+// OnInlineChosen requires the inline feedback to be enabled via @BotFather.
 type Router struct {
 	OnMessage                 func(*Message, *tg.Message)
 	OnEditedMessage           func(*Message, *tg.Message)
@@ -6,9 +6,10 @@ import (
 )
 
 // NewCallbacks makes new initialized callback router.
-func NewCallbacks() *Callbacks {
+// The ctx is used as a base context for the Cancel calls.
+func NewCallbacks(ctx tgot.Empty, clock ...Clock) *Callbacks {
 	return &Callbacks{
-		NewRouter[tgot.Query[tgot.CallbackAnswer], tgot.MessageID, *tg.CallbackQuery](),
+		NewRouter[tgot.Query[tgot.CallbackAnswer], tgot.MessageID, *tg.CallbackQuery](ctx, clock...),
 	}
 }
 
@@ -53,6 +54,9 @@ func (c *Callbacks) Unregister(sig tgot.MessageID) {
 	c.r.Unregister(sig)
 }
 
+// Close stops the underlying router.
+func (c *Callbacks) Close() { c.r.Close() }
+
 var _ Handler[tgot.Query[tgot.CallbackAnswer], tgot.MessageID, *tg.CallbackQuery] = &callbackWrapper{}
 
 type callbackWrapper struct {
@@ -6,8 +6,9 @@ import (
 )
 
 // NewPolls makes new poll answers router.
-func NewPolls() *Polls {
-	return &Polls{NewRouter[tgot.Empty, string, *tg.PollAnswer]()}
+// The ctx is used as a base context for the Cancel calls.
+func NewPolls(ctx tgot.Empty, clock ...Clock) *Polls {
+	return &Polls{NewRouter[tgot.Empty, string, *tg.PollAnswer](ctx, clock...)}
 }
 
 // Polls routes poll answers.
@@ -1,17 +1,59 @@
 package router
 
 import (
+	"container/heap"
 	"sync"
 	"time"
 
 	"github.com/karalef/tgot"
 )
 
+// Clock provides the current time and timers for the router.
+type Clock interface {
+	Now() time.Time
+	NewTimer(time.Duration) Timer
+}
+
+// Timer is a timer created by Clock. It behaves like time.Timer:
+// after Reset or Stop no stale values are received from C.
+type Timer interface {
+	C() <-chan time.Time
+	Reset(time.Duration) bool
+	Stop() bool
+}
+
+type systemClock struct{}
+
+func (systemClock) Now() time.Time                 { return time.Now() }
+func (systemClock) NewTimer(d time.Duration) Timer { return systemTimer{time.NewTimer(d)} }
+
+type systemTimer struct{ *time.Timer }
+
+func (t systemTimer) C() <-chan time.Time { return t.Timer.C }
+
 // NewRouter makes new initialized queries router.
-func NewRouter[Ctx tgot.Context[Ctx], Key comparable, Data any]() *Router[Ctx, Key, Data] {
-	return &Router[Ctx, Key, Data]{
-		handlers: make(map[Key]handler[Ctx, Key, Data]),
+// The ctx is used as a base context for the Cancel calls.
+// If the clock is not provided, the system clock is used.
+//
+// The router starts its own goroutine that expires timed out handlers,
+// so the router must be closed when it is no longer needed.
+func NewRouter[Ctx tgot.Context[Ctx], Key comparable, Data any](ctx tgot.Empty, clock ...Clock) *Router[Ctx, Key, Data] {
+	if ctx == nil {
+		panic("router: nil context")
+	}
+	r := &Router[Ctx, Key, Data]{
+		ctx:      ctx,
+		clock:    systemClock{},
+		handlers: make(map[Key]*entry[Ctx, Key, Data]),
+		wake:     make(chan struct{}, 1),
+		done:     make(chan struct{}),
+		closed:   make(chan struct{}),
+	}
+	if len(clock) > 0 && clock[0] != nil {
+		r.clock = clock[0]
 	}
+	go r.run()
+	return r
 }
 
 // BaseHandler provides base handler methods.
@@ -22,8 +64,8 @@ type BaseHandler[Key comparable] interface {
 	Timeout() time.Time
 
 	// Called when the handler times out.
-	// The current handler will be automatically unregistered so do not
-	// call Unregister from this function as this will cause a goroutine leaking.
+	// The ctx is a child of the router's context with the handler's name.
+	// The current handler is already unregistered when this function is called.
 	Cancel(tgot.BaseContext, Key)
 }
 
@@ -34,71 +76,210 @@ type Handler[Ctx tgot.Context[Ctx], Key comparable, Data any] interface {
 	Handle(Ctx, Key, Data)
 }
 
-type handler[Ctx tgot.Context[Ctx], Key comparable, Data any] struct {
+type entry[Ctx tgot.Context[Ctx], Key comparable, Data any] struct {
 	Handler[Ctx, Key, Data]
-	oneTime bool
+	key      Key
+	deadline time.Time
+	oneTime  bool
+	index    int
 }
 
 // Router routes queries by registered keys.
 type Router[Ctx tgot.Context[Ctx], Key comparable, Data any] struct {
-	handlers map[Key]handler[Ctx, Key, Data]
+	ctx      tgot.Empty
+	clock    Clock
+	handlers map[Key]*entry[Ctx, Key, Data]
+	queue    queue[Ctx, Key, Data]
 	mut      sync.Mutex
+
+	wake      chan struct{}
+	done      chan struct{}
+	closed    chan struct{}
+	closeOnce sync.Once
+}
+
+// Close stops the expiration goroutine and waits for it to exit.
+// The registered handlers are not canceled. The handlers registered after
+// Close are ignored since they could never expire.
+func (r *Router[Ctx, Key, Data]) Close() {
+	r.closeOnce.Do(func() { close(r.done) })
+	<-r.closed
 }
 
-func (r *Router[Ctx, Key, Data]) gc(ctx Ctx) {
-	t := time.Now()
-	for key, h := range r.handlers {
-		if t.Before(h.Timeout()) {
-			continue
+func (r *Router[Ctx, Key, Data]) run() {
+	defer close(r.closed)
+	var timer Timer
+	defer func() {
+		if timer != nil {
+			timer.Stop()
 		}
-		r.unregister(key)
-		h.Cancel(ctx.WithName(h.Name()), key)
+	}()
+	for {
+		var after <-chan time.Time
+		r.mut.Lock()
+		switch {
+		case len(r.queue) == 0:
+			if timer != nil {
+				timer.Stop()
+			}
+		case timer == nil:
+			timer = r.clock.NewTimer(r.queue[0].deadline.Sub(r.clock.Now()))
+			after = timer.C()
+		default:
+			timer.Reset(r.queue[0].deadline.Sub(r.clock.Now()))
+			after = timer.C()
+		}
+		r.mut.Unlock()
+
+		select {
+		case <-r.done:
+			return
+		case <-r.wake:
+		case <-after:
+			r.expire()
+		}
+	}
+}
+
+func (r *Router[Ctx, Key, Data]) isClosed() bool {
+	select {
+	case <-r.done:
+		return true
+	default:
+		return false
+	}
+}
+
+func (r *Router[Ctx, Key, Data]) notify() {
+	select {
+	case r.wake <- struct{}{}:
+	default:
+	}
+}
+
+func (r *Router[Ctx, Key, Data]) expire() {
+	now := r.clock.Now()
+	var expired []*entry[Ctx, Key, Data]
+	r.mut.Lock()
+	for len(r.queue) > 0 && !now.Before(r.queue[0].deadline) {
+		e := heap.Pop(&r.queue).(*entry[Ctx, Key, Data])
+		delete(r.handlers, e.key)
+		expired = append(expired, e)
+	}
+	r.mut.Unlock()
+
+	for _, e := range expired {
+		r.cancel(e)
 	}
 }
 
+func (r *Router[Ctx, Key, Data]) cancel(e *entry[Ctx, Key, Data]) {
+	e.Cancel(r.ctx.WithName(e.Name()), e.key)
+}
+
 // Route routes update.
 func (r *Router[Ctx, Key, Data]) Route(ctx Ctx, key Key, data Data) {
 	r.mut.Lock()
-	r.gc(ctx)
-	h, ok := r.handlers[key]
+	e, ok := r.handlers[key]
 	if !ok {
 		r.mut.Unlock()
 		return
 	}
-	if h.oneTime {
-		r.unregister(key)
+	expired := !r.clock.Now().Before(e.deadline)
+	if e.oneTime || expired {
+		r.unregister(e)
 	}
 	r.mut.Unlock()
 
-	h.Handle(ctx.WithName(h.Name()), key, data)
+	if expired {
+		r.cancel(e)
+		return
+	}
+	e.Handle(ctx.WithName(e.Name()), key, data)
 }
 
-func (r *Router[Ctx, Key, Data]) reg(key Key, h handler[Ctx, Key, Data]) {
-	if h.Handler == nil || h.Timeout().Before(time.Now()) {
+func (r *Router[Ctx, Key, Data]) reg(key Key, h Handler[Ctx, Key, Data], oneTime bool) {
+	if h == nil || r.isClosed() {
+		return
+	}
+	deadline := h.Timeout()
+	if deadline.Before(r.clock.Now()) {
 		return
 	}
+	e := &entry[Ctx, Key, Data]{
+		Handler:  h,
+		key:      key,
+		deadline: deadline,
+		oneTime:  oneTime,
+	}
+
 	r.mut.Lock()
-	r.handlers[key] = h
+	if old, ok := r.handlers[key]; ok {
+		e.index = old.index
+		r.queue[e.index] = e
+		heap.Fix(&r.queue, e.index)
+	} else {
+		heap.Push(&r.queue, e)
+	}
+	r.handlers[key] = e
+	first := r.queue[0] == e
 	r.mut.Unlock()
+
+	if first {
+		r.notify()
+	}
 }
 
 // Register registers handler for key.
 func (r *Router[Ctx, Key, Data]) Register(key Key, h Handler[Ctx, Key, Data]) {
-	r.reg(key, handler[Ctx, Key, Data]{Handler: h})
+	r.reg(key, h, false)
 }
 
 // RegisterOneTime registers handler for key which will be unregistered after first call.
 func (r *Router[Ctx, Key, Data]) RegisterOneTime(key Key, h Handler[Ctx, Key, Data]) {
-	r.reg(key, handler[Ctx, Key, Data]{Handler: h, oneTime: true})
+	r.reg(key, h, true)
 }
 
-func (r *Router[Ctx, Key, Data]) unregister(key Key) {
-	delete(r.handlers, key)
+func (r *Router[Ctx, Key, Data]) unregister(e *entry[Ctx, Key, Data]) {
+	delete(r.handlers, e.key)
+	heap.Remove(&r.queue, e.index)
 }
 
 // Unregister deletes handler associated with the key.
 func (r *Router[Ctx, Key, Data]) Unregister(key Key) {
 	r.mut.Lock()
-	r.unregister(key)
+	if e, ok := r.handlers[key]; ok {
+		r.unregister(e)
+	}
 	r.mut.Unlock()
 }
+
+// queue is a min-heap of handlers ordered by deadline.
+type queue[Ctx tgot.Context[Ctx], Key comparable, Data any] []*entry[Ctx, Key, Data]
+
+func (q queue[Ctx, Key, Data]) Len() int { return len(q) }
+
+func (q queue[Ctx, Key, Data]) Less(i, j int) bool {
+	return q[i].deadline.Before(q[j].deadline)
+}
+
+func (q queue[Ctx, Key, Data]) Swap(i, j int) {
+	q[i], q[j] = q[j], q[i]
+	q[i].index = i
+	q[j].index = j
+}
+
+func (q *queue[Ctx, Key, Data]) Push(x any) {
+	e := x.(*entry[Ctx, Key, Data])
+	e.index = len(*q)
+	*q = append(*q, e)
+}
+
+func (q *queue[Ctx, Key, Data]) Pop() any {
+	old := *q
+	n := len(old)
+	e := old[n-1]
+	old[n-1] = nil
+	*q = old[:n-1]
+	return e
+}
@@ -1,13 +1,216 @@
 package router
 
 import (
+	"context"
+	"sync"
 	"testing"
+	"time"
 
 	"github.com/karalef/tgot"
 	"github.com/karalef/tgot/api/tg"
 )
 
+func testContext() tgot.Empty {
+	return (*tgot.Bot)(nil).NewContext(context.Background(), "test")
+}
+
+type fakeClock struct {
+	mut    sync.Mutex
+	now    time.Time
+	timers []*fakeTimer
+}
+
+type fakeTimer struct {
+	clock  *fakeClock
+	at     time.Time
+	active bool
+	ch     chan time.Time
+}
+
+func newFakeClock() *fakeClock {
+	return &fakeClock{now: time.Unix(1000, 0)}
+}
+
+func (c *fakeClock) Now() time.Time {
+	c.mut.Lock()
+	defer c.mut.Unlock()
+	return c.now
+}
+
+func (c *fakeClock) NewTimer(d time.Duration) Timer {
+	t := &fakeTimer{clock: c, ch: make(chan time.Time, 1)}
+	c.mut.Lock()
+	c.timers = append(c.timers, t)
+	c.mut.Unlock()
+	t.Reset(d)
+	return t
+}
+
+func (c *fakeClock) Advance(d time.Duration) {
+	c.mut.Lock()
+	defer c.mut.Unlock()
+	c.now = c.now.Add(d)
+	for _, t := range c.timers {
+		t.fire()
+	}
+}
+
+// fire sends the time if the timer is expired. The clock must be locked.
+func (t *fakeTimer) fire() {
+	if t.active && !t.clock.now.Before(t.at) {
+		t.active = false
+		t.ch <- t.clock.now
+	}
+}
+
+func (t *fakeTimer) C() <-chan time.Time { return t.ch }
+
+func (t *fakeTimer) Reset(d time.Duration) bool {
+	t.clock.mut.Lock()
+	defer t.clock.mut.Unlock()
+	active := t.stop()
+	t.at, t.active = t.clock.now.Add(d), true
+	t.fire()
+	return active
+}
+
+func (t *fakeTimer) Stop() bool {
+	t.clock.mut.Lock()
+	defer t.clock.mut.Unlock()
+	return t.stop()
+}
+
+func (t *fakeTimer) stop() bool {
+	active := t.active
+	t.active = false
+	select {
+	case <-t.ch:
+	default:
+	}
+	return active
+}
+
+type testHandler struct {
+	name     string
+	timeout  time.Time
+	handled  chan string
+	canceled chan string
+}
+
+func (h *testHandler) Name() string       { return h.name }
+func (h *testHandler) Timeout() time.Time { return h.timeout }
+
+func (h *testHandler) Cancel(ctx tgot.BaseContext, key string) {
+	if ctx.Path() != "test::"+h.name {
+		panic("unexpected cancel context path: " + ctx.Path())
+	}
+	h.canceled <- key
+}
+
+func (h *testHandler) Handle(_ tgot.Empty, key string, _ *tg.PollAnswer) {
+	h.handled <- key
+}
+
+func newTestHandler(name string, timeout time.Time) *testHandler {
+	return &testHandler{
+		name:     name,
+		timeout:  timeout,
+		handled:  make(chan string, 1),
+		canceled: make(chan string, 1),
+	}
+}
+
+func expect(t *testing.T, ch <-chan string, key string) {
+	t.Helper()
+	select {
+	case k := <-ch:
+		if k != key {
+			t.Fatalf("expected %s, got %s", key, k)
+		}
+	case <-time.After(time.Second):
+		t.Fatalf("%s is not received", key)
+	}
+}
+
+func expectNone(t *testing.T, ch <-chan string) {
+	t.Helper()
+	select {
+	case k := <-ch:
+		t.Fatalf("unexpected %s", k)
+	case <-time.After(10 * time.Millisecond):
+	}
+}
+
+func TestRouterExpire(t *testing.T) {
+	clock := newFakeClock()
+	r := NewRouter[tgot.Empty, string, *tg.PollAnswer](testContext(), clock)
+	defer r.Close()
+
+	late := newTestHandler("late", clock.Now().Add(2*time.Minute))
+	early := newTestHandler("early", clock.Now().Add(time.Minute))
+	r.Register("late", late)
+	r.Register("early", early)
+
+	clock.Advance(30 * time.Second)
+	expectNone(t, early.canceled)
+
+	clock.Advance(30 * time.Second)
+	expect(t, early.canceled, "early")
+	expectNone(t, late.canceled)
+
+	clock.Advance(time.Minute)
+	expect(t, late.canceled, "late")
+}
+
+func TestRouterRoute(t *testing.T) {
+	clock := newFakeClock()
+	r := NewRouter[tgot.Empty, string, *tg.PollAnswer](testContext(), clock)
+	defer r.Close()
+
+	h := newTestHandler("once", clock.Now().Add(time.Minute))
+	r.RegisterOneTime("once", h)
+	r.Route(testContext(), "once", nil)
+	expect(t, h.handled, "once")
+
+	r.Route(testContext(), "once", nil)
+	expectNone(t, h.handled)
+
+	clock.Advance(time.Minute)
+	expectNone(t, h.canceled)
+}
+
+func TestRouterUnregister(t *testing.T) {
+	clock := newFakeClock()
+	r := NewRouter[tgot.Empty, string, *tg.PollAnswer](testContext(), clock)
+	defer r.Close()
+
+	h := newTestHandler("unreg", clock.Now().Add(time.Minute))
+	r.Register("unreg", h)
+	r.Unregister("unreg")
+
+	clock.Advance(time.Minute)
+	expectNone(t, h.canceled)
+
+	r.Register("expired", newTestHandler("expired", clock.Now().Add(-time.Second)))
+	r.Route(testContext(), "expired", nil)
+	if len(r.handlers) != 0 {
+		t.Fatal("expired handler is registered")
+	}
+}
+
 func TestRouter(t *testing.T) {
-	r := NewRouter[tgot.Query[tgot.CallbackAnswer], tgot.MessageID, *tg.CallbackQuery]()
-	r.Register(tgot.MessageID{}, nil)
+	r := NewRouter[tgot.Empty, string, *tg.PollAnswer](testContext())
+	r.Register("nil", nil)
+
+	h := newTestHandler("real", time.Now().Add(20*time.Millisecond))
+	r.Register("real", h)
+	r.Route(testContext(), "real", nil)
+	expect(t, h.handled, "real")
+	expect(t, h.canceled, "real")
+
+	r.Close()
+	r.Register("closed", newTestHandler("closed", time.Now().Add(time.Minute)))
+	if len(r.handlers) != 0 {
+		t.Fatal("handler is registered after Close")
+	}
 }
@@ -16,6 +16,22 @@ type CaptionData struct {
 	Entities  []tg.MessageEntity `tg:"caption_entities"`
 }
 
+func (c CaptionData) captionData() CaptionData { return c }
+
+// captioned is implemented by the sendables with caption.
+type captioned interface {
+	Sendable
+	captionData() CaptionData
+	withCaption(CaptionData) Sendable
+}
+
+// uploadable is implemented by the sendables with a single media file.
+type uploadable interface {
+	Sendable
+	media() tg.Inputtable
+	withMedia(tg.Inputtable) Sendable
+}
+
 // SendOptions cointains common send* parameters.
 type SendOptions struct {
 	MessageEffectID     string             `tg:"message_effect_id"`
@@ -55,7 +71,10 @@ type Photo struct {
 	ShowCaptionAboveMedia bool           `tg:"show_caption_above_media"`
 }
 
-func (Photo) sendMethod() string { return "sendPhoto" }
+func (Photo) sendMethod() string                   { return "sendPhoto" }
+func (p Photo) withCaption(c CaptionData) Sendable { p.CaptionData = c; return p }
+func (p Photo) media() tg.Inputtable               { return p.Photo }
+func (p Photo) withMedia(f tg.Inputtable) Sendable { p.Photo = f; return p }
 
 var _ Sendable = Audio{}
 
@@ -70,7 +89,10 @@ type Audio struct {
 	ReplyMarkup tg.ReplyMarkup `tg:"reply_markup"`
 }
 
-func (Audio) sendMethod() string { return "sendAudio" }
+func (Audio) sendMethod() string                   { return "sendAudio" }
+func (a Audio) withCaption(c CaptionData) Sendable { a.CaptionData = c; return a }
+func (a Audio) media() tg.Inputtable               { return a.Audio }
+func (a Audio) withMedia(f tg.Inputtable) Sendable { a.Audio = f; return a }
 
 var _ Sendable = Document{}
 
@@ -83,7 +105,10 @@ type Document struct {
 	ReplyMarkup          tg.ReplyMarkup `tg:"reply_markup"`
 }
 
-func (Document) sendMethod() string { return "sendDocument" }
+func (Document) sendMethod() string                   { return "sendDocument" }
+func (d Document) withCaption(c CaptionData) Sendable { d.CaptionData = c; return d }
+func (d Document) media() tg.Inputtable               { return d.Document }
+func (d Document) withMedia(f tg.Inputtable) Sendable { d.Document = f; return d }
 
 var _ Sendable = Video{}
 
@@ -103,7 +128,10 @@ type Video struct {
 	ShowCaptionAboveMedia bool            `tg:"show_caption_above_media"`
 }
 
-func (Video) sendMethod() string { return "sendVideo" }
+func (Video) sendMethod() string                   { return "sendVideo" }
+func (v Video) withCaption(c CaptionData) Sendable { v.CaptionData = c; return v }
+func (v Video) media() tg.Inputtable               { return v.Video }
+func (v Video) withMedia(f tg.Inputtable) Sendable { v.Video = f; return v }
 
 var _ Sendable = Animation{}
 
@@ -120,7 +148,10 @@ type Animation struct {
 	ShowCaptionAboveMedia bool           `tg:"show_caption_above_media"`
 }
 
-func (Animation) sendMethod() string { return "sendAnimation" }
+func (Animation) sendMethod() string                   { return "sendAnimation" }
+func (a Animation) withCaption(c CaptionData) Sendable { a.CaptionData = c; return a }
+func (a Animation) media() tg.Inputtable               { return a.Animation }
+func (a Animation) withMedia(f tg.Inputtable) Sendable { a.Animation = f; return a }
 
 var _ Sendable = Voice{}
 
@@ -132,7 +163,10 @@ type Voice struct {
 	ReplyMarkup tg.ReplyMarkup `tg:"reply_markup"`
 }
 
-func (Voice) sendMethod() string { return "sendVoice" }
+func (Voice) sendMethod() string                   { return "sendVoice" }
+func (v Voice) withCaption(c CaptionData) Sendable { v.CaptionData = c; return v }
+func (v Voice) media() tg.Inputtable               { return v.Voice }
+func (v Voice) withMedia(f tg.Inputtable) Sendable { v.Voice = f; return v }
 
 var _ Sendable = VideoNote{}
 
@@ -145,7 +179,9 @@ type VideoNote struct {
 	ReplyMarkup tg.ReplyMarkup `tg:"reply_markup"`
 }
 
-func (VideoNote) sendMethod() string { return "sendVideoNote" }
+func (VideoNote) sendMethod() string                   { return "sendVideoNote" }
+func (v VideoNote) media() tg.Inputtable               { return v.VideoNote }
+func (v VideoNote) withMedia(f tg.Inputtable) Sendable { v.VideoNote = f; return v }
 
 var _ Sendable = PaidMedia{}
 
@@ -158,7 +194,8 @@ type PaidMedia struct {
 	ShowCaptionAboveMedia bool `tg:"show_caption_above_media"`
 }
 
-func (PaidMedia) sendMethod() string { return "sendPaidMedia" }
+func (PaidMedia) sendMethod() string                   { return "sendPaidMedia" }
+func (p PaidMedia) withCaption(c CaptionData) Sendable { p.CaptionData = c; return p }
 
 var _ Sendable = Location{}
 
@@ -252,7 +289,9 @@ type Sticker struct {
 	ReplyMarkup tg.ReplyMarkup `tg:"reply_markup"`
 }
 
-func (Sticker) sendMethod() string { return "sendSticker" }
+func (Sticker) sendMethod() string                   { return "sendSticker" }
+func (s Sticker) media() tg.Inputtable               { return s.Sticker }
+func (s Sticker) withMedia(f tg.Inputtable) Sendable { s.Sticker = f; return s }
 
 var _ Sendable = Game{}
 
@@ -0,0 +1,47 @@
+package tgot
+
+import (
+	"testing"
+
+	"github.com/karalef/tgot/api"
+	"github.com/karalef/tgot/api/tg"
+)
+
+var benchSendables = []struct {
+	name string
+	s    Sendable
+}{
+	{"Text", Text{
+		Text:     "Hello, world!",
+		Entities: []tg.MessageEntity{{Type: tg.EntityBold, Offset: 0, Length: 5}},
+		ReplyMarkup: &tg.InlineKeyboardMarkup{
+			Keyboard: [][]tg.InlineKeyboardButton{{{Text: "button", CallbackData: "data"}}},
+		},
+	}},
+	{"Photo", Photo{
+		Photo:       tg.FileID("AgACAgIAAxkBAAIBAAFmZ"),
+		CaptionData: CaptionData{Caption: "caption", ParseMode: tg.HTML},
+		HasSpoiler:  true,
+	}},
+	{"Invoice", Invoice{
+		Title:          "title",
+		Description:    "description",
+		Payload:        "payload",
+		Currency:       "XTR",
+		Prices:         []tg.LabeledPrice{{Label: "price", Amount: 100}},
+		PhotoURL:       "https://example.com/photo.jpg",
+		NeedEmail:      true,
+		StartParameter: "start",
+	}},
+}
+
+func BenchmarkAddObject(b *testing.B) {
+	for _, bs := range benchSendables {
+		b.Run(bs.name, func(b *testing.B) {
+			b.ReportAllocs()
+			for i := 0; i < b.N; i++ {
+				api.NewDataFrom(bs.s).Put()
+			}
+		})
+	}
+}
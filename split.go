@@ -0,0 +1,291 @@
+package tgot
+
+import (
+	"slices"
+	"unicode"
+
+	"github.com/karalef/tgot/api/format"
+	"github.com/karalef/tgot/api/tg"
+)
+
+// message length limits in UTF-16 code units.
+const (
+	MaxTextLength    = 4096
+	MaxCaptionLength = 1024
+)
+
+// TextPart is a part of the split text.
+type TextPart struct {
+	Text     string
+	Entities []tg.MessageEntity
+}
+
+// SplitText splits the text into parts no longer than limit UTF-16 code units
+// (MaxTextLength if limit is not positive). The text is split at paragraph,
+// line, sentence or word boundaries if possible, the separating whitespace is
+// dropped. The entities are cut and shifted to the parts, so the entities that
+// span the boundary (including code and pre) are continued in the next part.
+// If there is no such boundary, the part is cut before the word-like entity
+// (e.g. URL, code, pre or link) that would be broken in the middle, unless the
+// entity starts the part.
+func SplitText(text string, entities []tg.MessageEntity, limit int) []TextPart {
+	if limit <= 0 {
+		limit = MaxTextLength
+	}
+	s := newSplitter(text, entities)
+	var parts []TextPart
+	for !s.done() {
+		parts = append(parts, s.next(limit))
+	}
+	return parts
+}
+
+// splitter cuts the parts from the text.
+type splitter struct {
+	text     string
+	entities []tg.MessageEntity
+	runes    []rune
+	bytes    []int // byte offsets of the runes and the text length
+	units    []int // UTF-16 offsets of the runes and the text length
+	start    int   // index of the first rune of the next part
+}
+
+func newSplitter(text string, entities []tg.MessageEntity) *splitter {
+	s := &splitter{text: text, entities: entities}
+	n := 0
+	for b, r := range text {
+		s.runes = append(s.runes, r)
+		s.bytes = append(s.bytes, b)
+		s.units = append(s.units, n)
+		n += tg.UTF16RuneLen(r)
+	}
+	s.bytes = append(s.bytes, len(text))
+	s.units = append(s.units, n)
+	return s
+}
+
+func (s *splitter) done() bool { return s.start >= len(s.runes) }
+
+// next cuts the next part.
+func (s *splitter) next(limit int) TextPart {
+	end, next := s.cut(limit)
+	p := s.part(s.start, end)
+	s.start = next
+	return p
+}
+
+// cut returns the end of the part and the start of the next one.
+func (s *splitter) cut(limit int) (end, next int) {
+	n := len(s.runes)
+	base := s.units[s.start]
+	if s.units[n]-base <= limit {
+		return n, n
+	}
+	last := s.start + 1
+	for last < n && s.units[last+1]-base <= limit {
+		last++
+	}
+	seps := []func(i int) (int, bool){s.paragraph, s.line, s.sentence, s.word}
+	for k, sep := range seps {
+		// avoid too short parts unless splitting by words
+		from := base + limit/2
+		if k == len(seps)-1 {
+			from = base
+		}
+		for i := last; i > s.start && s.units[i] >= from; i-- {
+			if next, ok := sep(i); ok && !s.inside(i) {
+				return i, next
+			}
+		}
+	}
+	if i := s.entityStart(last); i > s.start {
+		return i, i
+	}
+	return last, last
+}
+
+// paragraph, line, sentence and word report whether the part can end before
+// the i-th rune and return the start of the next part.
+
+func (s *splitter) paragraph(i int) (int, bool) {
+	if i+1 >= len(s.runes) || s.runes[i-1] == '\n' || s.runes[i] != '\n' || s.runes[i+1] != '\n' {
+		return 0, false
+	}
+	for i < len(s.runes) && s.runes[i] == '\n' {
+		i++
+	}
+	return i, true
+}
+
+func (s *splitter) line(i int) (int, bool) {
+	return i + 1, s.runes[i] == '\n'
+}
+
+func (s *splitter) sentence(i int) (int, bool) {
+	switch s.runes[i-1] {
+	case '.', '!', '?', '…':
+		return i + 1, unicode.IsSpace(s.runes[i])
+	}
+	return 0, false
+}
+
+func (s *splitter) word(i int) (int, bool) {
+	return i + 1, unicode.IsSpace(s.runes[i])
+}
+
+// inside reports whether the i-th rune is inside an entity that should not
+// be split.
+func (s *splitter) inside(i int) bool {
+	pos := s.units[i]
+	for _, e := range s.entities {
+		if e.Offset < pos && pos < e.Offset+e.Length && !splittable(e.Type) {
+			return true
+		}
+	}
+	return false
+}
+
+// entityStart returns the index of the first rune of the entities that
+// would be broken by the cut before the i-th rune or i if there are none.
+func (s *splitter) entityStart(i int) int {
+	for moved := true; moved; {
+		moved = false
+		pos := s.units[i]
+		for _, e := range s.entities {
+			if e.Offset < pos && pos < e.Offset+e.Length && !cuttable(e.Type) {
+				i, _ = slices.BinarySearch(s.units, e.Offset)
+				moved = true
+				break
+			}
+		}
+	}
+	return i
+}
+
+// cuttable reports whether the entity can be cut in the middle of a word.
+func cuttable(t tg.EntityType) bool {
+	switch t {
+	case tg.EntityCode, tg.EntityCodeBlock, tg.EntityTextLink:
+		return false
+	}
+	return splittable(t)
+}
+
+// splittable reports whether the entity is still valid after splitting.
+func splittable(t tg.EntityType) bool {
+	switch t {
+	case tg.EntityMention, tg.EntityHashtag, tg.EntityCashtag, tg.EntityCommand,
+		tg.EntityURL, tg.EntityEmail, tg.EntityPhone, tg.EntityCustomEmoji:
+		return false
+	}
+	return true
+}
+
+// part returns the part between the runes.
+func (s *splitter) part(start, end int) TextPart {
+	p := TextPart{Text: s.text[s.bytes[start]:s.bytes[end]]}
+	lo, hi := s.units[start], s.units[end]
+	for _, e := range s.entities {
+		from, to := max(e.Offset, lo), min(e.Offset+e.Length, hi)
+		if from >= to {
+			continue
+		}
+		e.Offset, e.Length = from-lo, to-from
+		p.Entities = append(p.Entities, e)
+	}
+	return p
+}
+
+// parseSplitter parses the text if the parse mode is set.
+func parseSplitter(text string, pm tg.ParseMode, entities []tg.MessageEntity) (*splitter, error) {
+	if pm != "" {
+		var err error
+		text, entities, err = format.Parse(pm, text)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return newSplitter(text, entities), nil
+}
+
+// SendLong sends the text or the media with caption splitting it into several
+// messages if it exceeds the length limits. The text is split by SplitText.
+// The caption overflow is sent as the following text messages. The text with
+// parse mode is parsed by the format package.
+//
+// The reply parameters and the message effect are used only for the first
+// message, the reply markup of the text is attached to the last one.
+// It returns the messages sent before the error.
+func (c *Chat) SendLong(s Sendable, opts ...SendOptions) ([]*tg.Message, error) {
+	var (
+		first Sendable
+		rest  *splitter
+		text  Text
+	)
+	switch v := s.(type) {
+	case Text:
+		if tg.UTF16Len(v.Text) <= MaxTextLength {
+			break
+		}
+		sp, err := parseSplitter(v.Text, v.ParseMode, v.Entities)
+		if err != nil {
+			return nil, err
+		}
+		text = v
+		text.ParseMode = ""
+		rest = sp
+	case captioned:
+		cd := v.captionData()
+		if tg.UTF16Len(cd.Caption) <= MaxCaptionLength {
+			break
+		}
+		sp, err := parseSplitter(cd.Caption, cd.ParseMode, cd.Entities)
+		if err != nil {
+			return nil, err
+		}
+		p := sp.next(MaxCaptionLength)
+		first = v.withCaption(CaptionData{Caption: p.Text, Entities: p.Entities})
+		rest = sp
+	}
+	if rest == nil {
+		msg, err := c.Send(s, opts...)
+		if err != nil {
+			return nil, err
+		}
+		return []*tg.Message{msg}, nil
+	}
+
+	var opt SendOptions
+	if len(opts) > 0 {
+		opt = opts[0]
+	}
+	var msgs []*tg.Message
+	send := func(s Sendable) error {
+		msg, err := c.Send(s, opt)
+		if err != nil {
+			return err
+		}
+		msgs = append(msgs, msg)
+		opt.ReplyParameters = tg.ReplyParameters{}
+		opt.MessageEffectID = ""
+		return nil
+	}
+	if first != nil {
+		if err := send(first); err != nil {
+			return msgs, err
+		}
+	}
+	markup := text.ReplyMarkup
+	for !rest.done() {
+		p := rest.next(MaxTextLength)
+		t := text
+		t.Text, t.Entities, t.ReplyMarkup = p.Text, p.Entities, nil
+		if rest.done() {
+			t.ReplyMarkup = markup
+		}
+		if err := send(t); err != nil {
+			return msgs, err
+		}
+	}
+	return msgs, nil
+}
@@ -0,0 +1,145 @@
+package tgot
+
+import (
+	"math/rand/v2"
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/karalef/tgot/api/tg"
+)
+
+func TestSplitText(t *testing.T) {
+	tests := []struct {
+		name     string
+		text     string
+		entities []tg.MessageEntity
+		limit    int
+		expected []TextPart
+	}{
+		{
+			name:     "short",
+			text:     "short text",
+			limit:    10,
+			expected: []TextPart{{Text: "short text"}},
+		},
+		{
+			name:  "paragraph",
+			text:  "first line\nsecond. line\n\n\nparagraph",
+			limit: 30,
+			expected: []TextPart{
+				{Text: "first line\nsecond. line"},
+				{Text: "paragraph"},
+			},
+		},
+		{
+			name:  "sentence",
+			text:  "One two. Three four five",
+			limit: 16,
+			expected: []TextPart{
+				{Text: "One two."},
+				{Text: "Three four five"},
+			},
+		},
+		{
+			name:     "word",
+			text:     "one two three",
+			entities: []tg.MessageEntity{{Type: tg.EntityBold, Offset: 4, Length: 9}},
+			limit:    8,
+			expected: []TextPart{
+				{Text: "one two", Entities: []tg.MessageEntity{{Type: tg.EntityBold, Offset: 4, Length: 3}}},
+				{Text: "three", Entities: []tg.MessageEntity{{Type: tg.EntityBold, Offset: 0, Length: 5}}},
+			},
+		},
+		{
+			name:     "pre",
+			text:     "x\nfmt.Println(1)\nfmt.Println(2)",
+			entities: []tg.MessageEntity{{Type: tg.EntityCodeBlock, Offset: 2, Length: 29, Language: "go"}},
+			limit:    20,
+			expected: []TextPart{
+				{Text: "x\nfmt.Println(1)", Entities: []tg.MessageEntity{{Type: tg.EntityCodeBlock, Offset: 2, Length: 14, Language: "go"}}},
+				{Text: "fmt.Println(2)", Entities: []tg.MessageEntity{{Type: tg.EntityCodeBlock, Offset: 0, Length: 14, Language: "go"}}},
+			},
+		},
+		{
+			name:     "unsplittable",
+			text:     "see https://example.com",
+			entities: []tg.MessageEntity{{Type: tg.EntityURL, Offset: 4, Length: 19}},
+			limit:    22,
+			expected: []TextPart{
+				{Text: "see"},
+				{Text: "https://example.com", Entities: []tg.MessageEntity{{Type: tg.EntityURL, Offset: 0, Length: 19}}},
+			},
+		},
+		{
+			name:     "hard cut before code",
+			text:     "aaaaaa" + "bbbbbbbb",
+			entities: []tg.MessageEntity{{Type: tg.EntityCode, Offset: 6, Length: 8}},
+			limit:    10,
+			expected: []TextPart{
+				{Text: "aaaaaa"},
+				{Text: "bbbbbbbb", Entities: []tg.MessageEntity{{Type: tg.EntityCode, Offset: 0, Length: 8}}},
+			},
+		},
+		{
+			name:     "hard cut of long link",
+			text:     "abcdefghij",
+			entities: []tg.MessageEntity{{Type: tg.EntityTextLink, Offset: 0, Length: 10, URL: "https://example.com"}},
+			limit:    6,
+			expected: []TextPart{
+				{Text: "abcdef", Entities: []tg.MessageEntity{{Type: tg.EntityTextLink, Offset: 0, Length: 6, URL: "https://example.com"}}},
+				{Text: "ghij", Entities: []tg.MessageEntity{{Type: tg.EntityTextLink, Offset: 0, Length: 4, URL: "https://example.com"}}},
+			},
+		},
+		{
+			name:  "surrogates",
+			text:  "😀😀😀",
+			limit: 3,
+			expected: []TextPart{
+				{Text: "😀"},
+				{Text: "😀"},
+				{Text: "😀"},
+			},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			parts := SplitText(test.text, test.entities, test.limit)
+			if !slices.EqualFunc(parts, test.expected, func(a, b TextPart) bool {
+				return a.Text == b.Text && slices.Equal(a.Entities, b.Entities)
+			}) {
+				t.Fatalf("expected %+v, got %+v", test.expected, parts)
+			}
+		})
+	}
+}
+
+func TestSplitTextLimits(t *testing.T) {
+	words := []string{"a", "слово", "😀", "word.", "\n", "\n\n", " "}
+	r := rand.New(rand.NewPCG(1, 2))
+	for range 500 {
+		var sb strings.Builder
+		for range r.IntN(200) {
+			sb.WriteString(words[r.IntN(len(words))])
+			sb.WriteByte(' ')
+		}
+		text := sb.String()
+		n := tg.UTF16Len(text)
+		entities := []tg.MessageEntity{{Type: tg.EntityItalic, Offset: 0, Length: n}}
+		limit := 5 + r.IntN(50)
+		for _, p := range SplitText(text, entities, limit) {
+			l := tg.UTF16Len(p.Text)
+			if l > limit || l == 0 {
+				t.Fatalf("part %q length %d, limit %d", p.Text, l, limit)
+			}
+			if !strings.Contains(text, p.Text) {
+				t.Fatalf("part %q is not in the text", p.Text)
+			}
+			for _, e := range p.Entities {
+				if e.Offset < 0 || e.Length <= 0 || e.Offset+e.Length > l {
+					t.Fatalf("entity %+v is out of part %q", e, p.Text)
+				}
+			}
+		}
+	}
+}
@@ -0,0 +1,163 @@
+package tgot
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/karalef/tgot/api/tg"
+)
+
+// Fragment writes the nested part of the formatted text.
+type Fragment = func(*TextBuilder)
+
+// TextBuilder builds the formatted text with entities.
+// The entity offsets and lengths are computed in UTF-16 code units.
+//
+// The formatting methods accept the content as strings, fmt.Stringer values
+// and fragments, so the entities can be nested:
+//
+//	var b TextBuilder
+//	b.Bold("bold ", func(b *TextBuilder) { b.Italic("and italic") })
+//
+// The zero value is ready to use.
+type TextBuilder struct {
+	sb       strings.Builder
+	entities []tg.MessageEntity
+	offset   int
+}
+
+// NewTextBuilder creates a new text builder.
+func NewTextBuilder() *TextBuilder { return &TextBuilder{} }
+
+// Plain writes the plain text.
+func (b *TextBuilder) Plain(s string) *TextBuilder {
+	b.sb.WriteString(s)
+	b.offset += tg.UTF16Len(s)
+	return b
+}
+
+// Plainf writes the formatted plain text.
+func (b *TextBuilder) Plainf(format string, args ...any) *TextBuilder {
+	return b.Plain(fmt.Sprintf(format, args...))
+}
+
+// Add writes the content without formatting.
+func (b *TextBuilder) Add(content ...any) *TextBuilder {
+	for _, c := range content {
+		switch c := c.(type) {
+		case string:
+			b.Plain(c)
+		case Fragment:
+			c(b)
+		case fmt.Stringer:
+			b.Plain(c.String())
+		default:
+			b.Plain(fmt.Sprint(c))
+		}
+	}
+	return b
+}
+
+// Entity writes the content wrapped in the entity.
+// The entity offset and length are set by the builder.
+// The empty entities are omitted.
+func (b *TextBuilder) Entity(e tg.MessageEntity, content ...any) *TextBuilder {
+	i := len(b.entities)
+	e.Offset = b.offset
+	b.entities = append(b.entities, e)
+	b.Add(content...)
+	if b.entities[i].Length = b.offset - e.Offset; b.entities[i].Length == 0 {
+		b.entities = append(b.entities[:i], b.entities[i+1:]...)
+	}
+	return b
+}
+
+func (b *TextBuilder) entity(t tg.EntityType, content []any) *TextBuilder {
+	return b.Entity(tg.MessageEntity{Type: t}, content...)
+}
+
+// Bold writes the bold text.
+func (b *TextBuilder) Bold(content ...any) *TextBuilder {
+	return b.entity(tg.EntityBold, content)
+}
+
+// Italic writes the italic text.
+func (b *TextBuilder) Italic(content ...any) *TextBuilder {
+	return b.entity(tg.EntityItalic, content)
+}
+
+// Underline writes the underlined text.
+func (b *TextBuilder) Underline(content ...any) *TextBuilder {
+	return b.entity(tg.EntityUnderline, content)
+}
+
+// Strike writes the strikethrough text.
+func (b *TextBuilder) Strike(content ...any) *TextBuilder {
+	return b.entity(tg.EntityStrikethrough, content)
+}
+
+// Spoiler writes the spoiler.
+func (b *TextBuilder) Spoiler(content ...any) *TextBuilder {
+	return b.entity(tg.EntitySpoiler, content)
+}
+
+// Code writes the monowidth string. It can't contain other entities.
+func (b *TextBuilder) Code(code string) *TextBuilder {
+	return b.entity(tg.EntityCode, []any{code})
+}
+
+// Pre writes the monowidth block with the optional programming language.
+// It can't contain other entities.
+func (b *TextBuilder) Pre(code, lang string) *TextBuilder {
+	return b.Entity(tg.MessageEntity{Type: tg.EntityCodeBlock, Language: lang}, code)
+}
+
+// Link writes the text link.
+func (b *TextBuilder) Link(url string, content ...any) *TextBuilder {
+	return b.Entity(tg.MessageEntity{Type: tg.EntityTextLink, URL: url}, content...)
+}
+
+// Mention writes the mention of the user without username.
+func (b *TextBuilder) Mention(user *tg.User, content ...any) *TextBuilder {
+	return b.Entity(tg.MessageEntity{Type: tg.EntityTextMention, User: user}, content...)
+}
+
+// CustomEmoji writes the custom emoji. The emoji is shown if the custom
+// emoji is not available.
+func (b *TextBuilder) CustomEmoji(emoji, id string) *TextBuilder {
+	return b.Entity(tg.MessageEntity{Type: tg.EntityCustomEmoji, CustomEmojiID: id}, emoji)
+}
+
+// Blockquote writes the block quotation.
+func (b *TextBuilder) Blockquote(content ...any) *TextBuilder {
+	return b.entity(tg.EntityBlockquote, content)
+}
+
+// ExpandableBlockquote writes the collapsed by default block quotation.
+func (b *TextBuilder) ExpandableBlockquote(content ...any) *TextBuilder {
+	return b.entity(tg.EntityExpandableBlockQuote, content)
+}
+
+// Len returns the length of the text in UTF-16 code units.
+func (b *TextBuilder) Len() int { return b.offset }
+
+// String returns the text without entities.
+func (b *TextBuilder) String() string { return b.sb.String() }
+
+// Entities returns the entities.
+func (b *TextBuilder) Entities() []tg.MessageEntity { return b.entities }
+
+// Text returns the text message.
+func (b *TextBuilder) Text() Text {
+	return Text{Text: b.String(), Entities: b.entities}
+}
+
+// EditText returns the parameters for editing the message text.
+func (b *TextBuilder) EditText() EditText {
+	return EditText{Text: b.String(), Entities: b.entities}
+}
+
+// Caption returns the caption.
+func (b *TextBuilder) Caption() CaptionData {
+	return CaptionData{Caption: b.String(), Entities: b.entities}
+}
@@ -0,0 +1,34 @@
+package tgot
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/karalef/tgot/api/tg"
+)
+
+func TestTextBuilder(t *testing.T) {
+	b := NewTextBuilder().
+		Plain("😀 ").
+		Bold("жирный ", func(b *TextBuilder) { b.Italic("и курсив") }).
+		Plain(" ").
+		Link("https://example.com", "🔗").
+		Italic().
+		Pre("code", "go")
+
+	if s := b.String(); s != "😀 жирный и курсив 🔗code" {
+		t.Fatalf("unexpected text %q", s)
+	}
+	expected := []tg.MessageEntity{
+		{Type: tg.EntityBold, Offset: 3, Length: 15},
+		{Type: tg.EntityItalic, Offset: 10, Length: 8},
+		{Type: tg.EntityTextLink, Offset: 19, Length: 2, URL: "https://example.com"},
+		{Type: tg.EntityCodeBlock, Offset: 21, Length: 4, Language: "go"},
+	}
+	if !slices.Equal(b.Entities(), expected) {
+		t.Fatalf("expected %+v, got %+v", expected, b.Entities())
+	}
+	if b.Len() != 25 {
+		t.Fatalf("expected length 25, got %d", b.Len())
+	}
+}